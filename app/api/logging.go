@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// RequestIDHeader propagates (or assigns) a correlation ID for a request,
+// echoed back on the response so it can be matched against logs.
+const RequestIDHeader = "X-Request-ID"
+
+type loggerCtxKey struct{}
+
+// RequestLogging assigns/propagates an X-Request-ID, injects a logger
+// carrying it into the request context, and logs method/path/status/duration
+// once the handler finishes.
+func RequestLogging(base *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requestID := r.Header.Get(RequestIDHeader)
+			if requestID == "" {
+				requestID = newRequestID()
+			}
+			w.Header().Set(RequestIDHeader, requestID)
+
+			logger := base.With("request_id", requestID)
+			ctx := context.WithValue(r.Context(), loggerCtxKey{}, logger)
+
+			rw := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			start := time.Now()
+
+			next.ServeHTTP(rw, r.WithContext(ctx))
+
+			logger.Info("request completed",
+				"method", r.Method,
+				"path", r.URL.Path,
+				"status", rw.status,
+				"duration_ms", time.Since(start).Milliseconds(),
+			)
+		})
+	}
+}
+
+// LoggerFromContext returns the request-scoped logger injected by
+// RequestLogging, or slog.Default() if none was injected (e.g. in tests that
+// call a handler directly).
+func LoggerFromContext(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerCtxKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// statusRecorder captures the status code written by a downstream handler so
+// it can be logged after the handler returns.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestLogging(t *testing.T) {
+	t.Run("assigns a request ID when the client sends none and echoes it back", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		handler := RequestLogging(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			OKResponse(w, map[string]string{"ok": "true"})
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		requestID := rec.Header().Get(RequestIDHeader)
+		require.NotEmpty(t, requestID)
+		assert.Contains(t, buf.String(), requestID)
+		assert.Contains(t, buf.String(), `"status":200`)
+	})
+
+	t.Run("propagates an incoming request ID instead of replacing it", func(t *testing.T) {
+		logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+		handler := RequestLogging(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
+		req.Header.Set(RequestIDHeader, "fixed-id")
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, "fixed-id", rec.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("injects a contextual logger the handler can retrieve", func(t *testing.T) {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+		handler := RequestLogging(logger)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			LoggerFromContext(r.Context()).Info("repository call failed", "error", "db exploded")
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Contains(t, buf.String(), "db exploded")
+	})
+}
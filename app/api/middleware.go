@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Timeout wraps h with a per-route deadline. The wrapped handler keeps
+// running in its own goroutine even after the deadline fires (Go has no way
+// to preempt it), but the timeoutWriter makes sure only the first response
+// - ours or the handler's - actually reaches the client: once the deadline
+// response is written, anything h still writes afterwards is discarded.
+//
+// ctx.Err() distinguishes why the request ended: DeadlineExceeded becomes a
+// 504 Gateway Timeout, Canceled (the client went away) becomes a 499 Client
+// Closed Request.
+func Timeout(h http.Handler, d time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, cancel := context.WithTimeout(r.Context(), d)
+		defer cancel()
+
+		tw := &timeoutWriter{ResponseWriter: w}
+		done := make(chan struct{})
+
+		go func() {
+			defer close(done)
+			h.ServeHTTP(tw, r.WithContext(ctx))
+		}()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			tw.timeout(ctx.Err())
+		}
+	})
+}
+
+// timeoutWriter guards the underlying ResponseWriter with a mutex so the
+// handler goroutine and the Timeout middleware can't interleave writes, and
+// drops any write attempted after the middleware has already responded.
+type timeoutWriter struct {
+	http.ResponseWriter
+	mu          sync.Mutex
+	wroteHeader bool
+	timedOut    bool
+}
+
+func (tw *timeoutWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.wroteHeader {
+		return
+	}
+	tw.wroteHeader = true
+	tw.ResponseWriter.WriteHeader(code)
+}
+
+func (tw *timeoutWriter) Write(b []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return len(b), nil
+	}
+	if !tw.wroteHeader {
+		tw.wroteHeader = true
+		tw.ResponseWriter.WriteHeader(http.StatusOK)
+	}
+	return tw.ResponseWriter.Write(b)
+}
+
+func (tw *timeoutWriter) timeout(err error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.wroteHeader {
+		return
+	}
+	tw.timedOut = true
+	tw.wroteHeader = true
+
+	if errors.Is(err, context.Canceled) {
+		ErrorResponse(tw.ResponseWriter, 499, "client closed request")
+		return
+	}
+	ErrorResponse(tw.ResponseWriter, http.StatusGatewayTimeout, "request timed out")
+}
+
+// WriteRepositoryError maps an error returned by a repository call to the
+// response it should produce: context errors (the request's deadline
+// middleware already raced the client for these, but a repository can also
+// observe them first) become 504/499. Anything else becomes a 500 with a
+// sanitized body - the detailed error, which may contain internal DB
+// messages, is only ever logged via the request-scoped logger.
+func WriteRepositoryError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		ErrorResponse(w, http.StatusGatewayTimeout, "request timed out")
+	case errors.Is(err, context.Canceled):
+		ErrorResponse(w, 499, "client closed request")
+	default:
+		LoggerFromContext(r.Context()).Error("repository call failed",
+			"error", err,
+			"method", r.Method,
+			"path", r.URL.Path,
+		)
+		ErrorResponse(w, http.StatusInternalServerError, "internal server error")
+	}
+}
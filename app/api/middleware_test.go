@@ -0,0 +1,89 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// MockCategoriesRepository-style slow handler: blocks until its request
+// context is cancelled, simulating a repository call stuck on a slow query.
+func blockingHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	t.Run("responds 504 when the handler exceeds the deadline", func(t *testing.T) {
+		handler := Timeout(blockingHandler(), 10*time.Millisecond)
+
+		req := httptest.NewRequest(http.MethodGet, "/slow", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+		assert.Contains(t, rec.Body.String(), "request timed out")
+	})
+
+	t.Run("responds 499 when the client disconnects", func(t *testing.T) {
+		handler := Timeout(blockingHandler(), time.Second)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		req := httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx)
+		rec := httptest.NewRecorder()
+
+		cancel()
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, 499, rec.Code)
+		assert.Contains(t, rec.Body.String(), "client closed request")
+	})
+
+	t.Run("passes through a handler that finishes before the deadline", func(t *testing.T) {
+		handler := Timeout(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			OKResponse(w, map[string]string{"status": "ok"})
+		}), time.Second)
+
+		req := httptest.NewRequest(http.MethodGet, "/fast", nil)
+		rec := httptest.NewRecorder()
+
+		handler.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), "ok")
+	})
+}
+
+func TestWriteRepositoryError(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+
+	t.Run("maps deadline exceeded to 504", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteRepositoryError(rec, req, context.DeadlineExceeded)
+		assert.Equal(t, http.StatusGatewayTimeout, rec.Code)
+	})
+
+	t.Run("maps canceled to 499", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteRepositoryError(rec, req, context.Canceled)
+		assert.Equal(t, 499, rec.Code)
+	})
+
+	t.Run("maps anything else to 500 without leaking the detailed error", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		WriteRepositoryError(rec, req, assertErr("database error"))
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "database error")
+		assert.Contains(t, rec.Body.String(), "internal server error")
+	})
+}
+
+type assertErr string
+
+func (e assertErr) Error() string { return string(e) }
@@ -0,0 +1,30 @@
+// Package applog builds the process-wide structured logger from the
+// LOG_LEVEL environment variable.
+package applog
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// NewFromEnv builds a JSON slog.Logger writing to stdout at the level named
+// by LOG_LEVEL (debug|info|warn|error, case-insensitive). Unset or
+// unrecognized values default to info.
+func NewFromEnv() *slog.Logger {
+	handler := slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{Level: parseLevel(os.Getenv("LOG_LEVEL"))})
+	return slog.New(handler)
+}
+
+func parseLevel(s string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
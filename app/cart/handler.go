@@ -0,0 +1,139 @@
+package cart
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/mytheresa/go-hiring-challenge/app/api"
+	"github.com/mytheresa/go-hiring-challenge/models"
+)
+
+type ItemDTO struct {
+	ProductCode string `json:"product_code"`
+	Quantity    int    `json:"quantity"`
+	Price       string `json:"price"`
+	Subtotal    string `json:"subtotal"`
+}
+
+type Response struct {
+	ID    uint      `json:"id"`
+	Items []ItemDTO `json:"items"`
+	Total string    `json:"total"`
+}
+
+type AddItemRequest struct {
+	ProductCode string `json:"product_code"`
+	Quantity    int    `json:"quantity"`
+}
+
+type Handler struct {
+	service *Service
+}
+
+func NewHandler(service *Service) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) HandleAddOrUpdateItem(w http.ResponseWriter, r *http.Request) {
+	cartID, err := cartIDFromPath(r)
+	if err != nil {
+		api.ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var req AddItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.ProductCode == "" {
+		api.ErrorResponse(w, http.StatusBadRequest, "product_code is required")
+		return
+	}
+
+	if err := h.service.AddOrUpdateItem(r.Context(), cartID, req.ProductCode, req.Quantity); err != nil {
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.respondWithCart(w, r, cartID)
+}
+
+func (h *Handler) HandleRemoveItem(w http.ResponseWriter, r *http.Request) {
+	cartID, err := cartIDFromPath(r)
+	if err != nil {
+		api.ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	code := r.PathValue("code")
+	if code == "" {
+		api.ErrorResponse(w, http.StatusBadRequest, "product code is required")
+		return
+	}
+
+	if err := h.service.RemoveItem(r.Context(), cartID, code); err != nil {
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.respondWithCart(w, r, cartID)
+}
+
+func (h *Handler) HandleGetCart(w http.ResponseWriter, r *http.Request) {
+	cartID, err := cartIDFromPath(r)
+	if err != nil {
+		api.ErrorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	h.respondWithCart(w, r, cartID)
+}
+
+func (h *Handler) respondWithCart(w http.ResponseWriter, r *http.Request, cartID uint) {
+	cart, err := h.service.GetCart(r.Context(), cartID)
+	if err != nil {
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	items := make([]ItemDTO, len(cart.Items))
+	for i, it := range cart.Items {
+		items[i] = ItemDTO{
+			ProductCode: it.ProductCode,
+			Quantity:    it.Quantity,
+			Price:       it.Price.String(),
+			Subtotal:    it.Subtotal.String(),
+		}
+	}
+
+	api.OKResponse(w, Response{
+		ID:    cart.ID,
+		Items: items,
+		Total: cart.Total.String(),
+	})
+}
+
+func (h *Handler) writeServiceError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, ErrInvalidQuantity):
+		api.ErrorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, ErrProductNotFound):
+		api.ErrorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, models.ErrCartItemNotFound):
+		api.ErrorResponse(w, http.StatusNotFound, "cart item not found")
+	default:
+		api.WriteRepositoryError(w, r, err)
+	}
+}
+
+func cartIDFromPath(r *http.Request) (uint, error) {
+	id, err := strconv.ParseUint(r.PathValue("id"), 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid cart id")
+	}
+	return uint(id), nil
+}
@@ -0,0 +1,285 @@
+package cart
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// MockProductsRepository implements models.ProductsRepositoryInterface for
+// testing, keyed by product code.
+type MockProductsRepository struct {
+	products map[string]models.Product
+}
+
+func (m *MockProductsRepository) GetAllProducts(ctx context.Context) ([]models.Product, error) {
+	return nil, nil
+}
+
+func (m *MockProductsRepository) GetProducts(ctx context.Context, opts models.ProductQueryParameters) ([]models.Product, int64, error) {
+	return nil, 0, nil
+}
+
+func (m *MockProductsRepository) GetProductByCode(ctx context.Context, code string) (*models.Product, error) {
+	p, ok := m.products[code]
+	if !ok {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return &p, nil
+}
+
+// MockCartRepository implements models.CartRepositoryInterface in memory,
+// keyed by cart ID then product ID. The mutex stands in for the transaction
+// and upsert CartRepository.AddOrUpdateItem relies on, so tests can exercise
+// concurrent callers without a real database. Like CartRepository.GetCart's
+// Preload, GetCart fills each line's Product from the products mock that
+// newTestHandler links in.
+type MockCartRepository struct {
+	mu       sync.Mutex
+	carts    map[uint]map[uint]models.CartItem
+	products *MockProductsRepository
+}
+
+func newMockCartRepository() *MockCartRepository {
+	return &MockCartRepository{carts: map[uint]map[uint]models.CartItem{}}
+}
+
+func (m *MockCartRepository) GetCart(ctx context.Context, cartID uint) (*models.Cart, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cart := &models.Cart{ID: cartID}
+	for _, item := range m.carts[cartID] {
+		if m.products != nil {
+			for _, p := range m.products.products {
+				if p.ID == item.ProductID {
+					item.Product = p
+				}
+			}
+		}
+		cart.Items = append(cart.Items, item)
+	}
+	return cart, nil
+}
+
+func (m *MockCartRepository) AddOrUpdateItem(ctx context.Context, cartID uint, productID uint, price decimal.Decimal, quantity int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.carts[cartID] == nil {
+		m.carts[cartID] = map[uint]models.CartItem{}
+	}
+	m.carts[cartID][productID] = models.CartItem{
+		CartID:    cartID,
+		ProductID: productID,
+		Price:     price,
+		Quantity:  quantity,
+	}
+	return nil
+}
+
+func (m *MockCartRepository) RemoveItem(ctx context.Context, cartID uint, productID uint) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.carts[cartID][productID]; !ok {
+		return models.ErrCartItemNotFound
+	}
+	delete(m.carts[cartID], productID)
+	return nil
+}
+
+func newTestHandler(products *MockProductsRepository, carts *MockCartRepository) *Handler {
+	carts.products = products
+	return NewHandler(NewService(carts, products))
+}
+
+func TestHandleAddOrUpdateItem(t *testing.T) {
+	t.Run("adds a new item and returns the cart", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		handler := newTestHandler(products, newMockCartRepository())
+
+		body := bytes.NewBufferString(`{"product_code":"PROD001","quantity":2}`)
+		req := httptest.NewRequest(http.MethodPost, "/carts/1/items", body)
+		req.SetPathValue("id", "1")
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddOrUpdateItem(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp Response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, "PROD001", resp.Items[0].ProductCode)
+		assert.Equal(t, 2, resp.Items[0].Quantity)
+		assert.Equal(t, "20", resp.Items[0].Subtotal)
+		assert.Equal(t, "20", resp.Total)
+	})
+
+	t.Run("rejects zero quantity", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		handler := newTestHandler(products, newMockCartRepository())
+
+		body := bytes.NewBufferString(`{"product_code":"PROD001","quantity":0}`)
+		req := httptest.NewRequest(http.MethodPost, "/carts/1/items", body)
+		req.SetPathValue("id", "1")
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddOrUpdateItem(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "quantity must be greater than zero")
+	})
+
+	t.Run("update replaces the existing quantity", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		carts := newMockCartRepository()
+		handler := newTestHandler(products, carts)
+
+		for _, qty := range []int{2, 5} {
+			body := bytes.NewBufferString(`{"product_code":"PROD001","quantity":` + strconv.Itoa(qty) + `}`)
+			req := httptest.NewRequest(http.MethodPost, "/carts/1/items", body)
+			req.SetPathValue("id", "1")
+			rec := httptest.NewRecorder()
+			handler.HandleAddOrUpdateItem(rec, req)
+			require.Equal(t, http.StatusOK, rec.Code)
+		}
+
+		var resp Response
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/carts/1", nil)
+		req.SetPathValue("id", "1")
+		handler.HandleGetCart(rec, req)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, 5, resp.Items[0].Quantity)
+	})
+
+	t.Run("returns 404 for an unknown product code", func(t *testing.T) {
+		handler := newTestHandler(&MockProductsRepository{products: map[string]models.Product{}}, newMockCartRepository())
+
+		body := bytes.NewBufferString(`{"product_code":"MISSING","quantity":1}`)
+		req := httptest.NewRequest(http.MethodPost, "/carts/1/items", body)
+		req.SetPathValue("id", "1")
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddOrUpdateItem(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("concurrent adds to the same line leave exactly one item behind", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		handler := newTestHandler(products, newMockCartRepository())
+
+		const writers = 20
+		var wg sync.WaitGroup
+		wg.Add(writers)
+		for i := 1; i <= writers; i++ {
+			go func(qty int) {
+				defer wg.Done()
+				body := bytes.NewBufferString(`{"product_code":"PROD001","quantity":` + strconv.Itoa(qty) + `}`)
+				req := httptest.NewRequest(http.MethodPost, "/carts/1/items", body)
+				req.SetPathValue("id", "1")
+				handler.HandleAddOrUpdateItem(httptest.NewRecorder(), req)
+			}(i)
+		}
+		wg.Wait()
+
+		var resp Response
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/carts/1", nil)
+		req.SetPathValue("id", "1")
+		handler.HandleGetCart(rec, req)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+		require.Len(t, resp.Items, 1, "concurrent adds to the same product must update one line, never create duplicates")
+		assert.GreaterOrEqual(t, resp.Items[0].Quantity, 1)
+		assert.LessOrEqual(t, resp.Items[0].Quantity, writers)
+	})
+}
+
+func TestHandleRemoveItem(t *testing.T) {
+	t.Run("removes an existing item", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		carts := newMockCartRepository()
+		carts.carts[1] = map[uint]models.CartItem{1: {CartID: 1, ProductID: 1, Price: decimal.NewFromFloat(10), Quantity: 2}}
+		handler := newTestHandler(products, carts)
+
+		req := httptest.NewRequest(http.MethodDelete, "/carts/1/items/PROD001", nil)
+		req.SetPathValue("id", "1")
+		req.SetPathValue("code", "PROD001")
+		rec := httptest.NewRecorder()
+
+		handler.HandleRemoveItem(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"items":[]`)
+	})
+
+	t.Run("returns 404 for a nonexistent item", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		handler := newTestHandler(products, newMockCartRepository())
+
+		req := httptest.NewRequest(http.MethodDelete, "/carts/1/items/PROD001", nil)
+		req.SetPathValue("id", "1")
+		req.SetPathValue("code", "PROD001")
+		rec := httptest.NewRecorder()
+
+		handler.HandleRemoveItem(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestHandleGetCart(t *testing.T) {
+	t.Run("returns an empty cart", func(t *testing.T) {
+		handler := newTestHandler(&MockProductsRepository{products: map[string]models.Product{}}, newMockCartRepository())
+
+		req := httptest.NewRequest(http.MethodGet, "/carts/1", nil)
+		req.SetPathValue("id", "1")
+		rec := httptest.NewRecorder()
+
+		handler.HandleGetCart(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"total":"0"`)
+	})
+
+	t.Run("rejects a non-numeric cart id", func(t *testing.T) {
+		handler := newTestHandler(&MockProductsRepository{products: map[string]models.Product{}}, newMockCartRepository())
+
+		req := httptest.NewRequest(http.MethodGet, "/carts/abc", nil)
+		req.SetPathValue("id", "abc")
+		rec := httptest.NewRecorder()
+
+		handler.HandleGetCart(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
@@ -0,0 +1,99 @@
+package cart
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+)
+
+// ErrInvalidQuantity is returned when a caller tries to add or update an
+// item with a quantity that doesn't make sense (zero or negative).
+var ErrInvalidQuantity = errors.New("quantity must be greater than zero")
+
+// ErrProductNotFound is returned by AddOrUpdateItem and RemoveItem when
+// productCode doesn't name a product the catalog knows about, so callers
+// never need to reach into gorm's error types to tell a missing product
+// apart from a missing cart line.
+var ErrProductNotFound = errors.New("product not found")
+
+// Cart is the computed view of a cart returned by Service: per-line
+// subtotals and a cart total, derived server-side from the price snapshot
+// stored on each line.
+type Cart struct {
+	ID    uint
+	Items []Item
+	Total decimal.Decimal
+}
+
+type Item struct {
+	ProductCode string
+	Quantity    int
+	Price       decimal.Decimal
+	Subtotal    decimal.Decimal
+}
+
+// Service implements add/update/remove/list on top of CartRepositoryInterface
+// for persistence and ProductsRepositoryInterface to snapshot the current
+// price of a product at the moment it's added. Prices are never trusted
+// from the client.
+type Service struct {
+	carts    models.CartRepositoryInterface
+	products models.ProductsRepositoryInterface
+}
+
+func NewService(carts models.CartRepositoryInterface, products models.ProductsRepositoryInterface) *Service {
+	return &Service{carts: carts, products: products}
+}
+
+func (s *Service) AddOrUpdateItem(ctx context.Context, cartID uint, productCode string, quantity int) error {
+	if quantity <= 0 {
+		return ErrInvalidQuantity
+	}
+
+	product, err := s.products.GetProductByCode(ctx, productCode)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	return s.carts.AddOrUpdateItem(ctx, cartID, product.ID, product.Price, quantity)
+}
+
+func (s *Service) RemoveItem(ctx context.Context, cartID uint, productCode string) error {
+	product, err := s.products.GetProductByCode(ctx, productCode)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrProductNotFound
+		}
+		return err
+	}
+
+	return s.carts.RemoveItem(ctx, cartID, product.ID)
+}
+
+func (s *Service) GetCart(ctx context.Context, cartID uint) (*Cart, error) {
+	stored, err := s.carts.GetCart(ctx, cartID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load cart: %w", err)
+	}
+
+	cart := &Cart{ID: stored.ID, Total: decimal.Zero}
+	for _, i := range stored.Items {
+		subtotal := i.Price.Mul(decimal.NewFromInt(int64(i.Quantity)))
+		cart.Items = append(cart.Items, Item{
+			ProductCode: i.Product.Code,
+			Quantity:    i.Quantity,
+			Price:       i.Price,
+			Subtotal:    subtotal,
+		})
+		cart.Total = cart.Total.Add(subtotal)
+	}
+
+	return cart, nil
+}
@@ -0,0 +1,33 @@
+package cart
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+)
+
+// SessionHeader names the request header a client uses to identify its cart
+// on the /cart routes.
+//
+// A bearer JWT's "sub" claim would be the natural alternative, but this repo
+// has no signature verification (key/JWKS) wired up anywhere, and trusting an
+// unverified claim would let any caller read or mutate another customer's
+// cart - so that path isn't implemented until real verification exists.
+const SessionHeader = "X-Cart-Session-Id"
+
+// ErrMissingSession is returned when a request carries no SessionHeader.
+var ErrMissingSession = errors.New("missing cart session")
+
+// sessionCartID resolves the cart ID for a /cart request from SessionHeader.
+func sessionCartID(r *http.Request) (uint, error) {
+	v := r.Header.Get(SessionHeader)
+	if v == "" {
+		return 0, ErrMissingSession
+	}
+
+	id, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, errors.New("invalid session id")
+	}
+	return uint(id), nil
+}
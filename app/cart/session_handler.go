@@ -0,0 +1,82 @@
+package cart
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/mytheresa/go-hiring-challenge/app/api"
+)
+
+// HandleAddOrUpdateSessionItem is the session-identified counterpart of
+// HandleAddOrUpdateItem, registered under /cart/items: the cart belongs to
+// whoever sessionCartID resolves the request to, rather than a cart ID in
+// the path.
+func (h *Handler) HandleAddOrUpdateSessionItem(w http.ResponseWriter, r *http.Request) {
+	cartID, err := sessionCartID(r)
+	if err != nil {
+		h.writeSessionError(w, err)
+		return
+	}
+
+	var req AddItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.ErrorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if req.ProductCode == "" {
+		api.ErrorResponse(w, http.StatusBadRequest, "product_code is required")
+		return
+	}
+
+	if err := h.service.AddOrUpdateItem(r.Context(), cartID, req.ProductCode, req.Quantity); err != nil {
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.respondWithCart(w, r, cartID)
+}
+
+// HandleRemoveSessionItem is the session-identified counterpart of
+// HandleRemoveItem, registered under /cart/items/{code}.
+func (h *Handler) HandleRemoveSessionItem(w http.ResponseWriter, r *http.Request) {
+	cartID, err := sessionCartID(r)
+	if err != nil {
+		h.writeSessionError(w, err)
+		return
+	}
+
+	code := r.PathValue("code")
+	if code == "" {
+		api.ErrorResponse(w, http.StatusBadRequest, "product code is required")
+		return
+	}
+
+	if err := h.service.RemoveItem(r.Context(), cartID, code); err != nil {
+		h.writeServiceError(w, r, err)
+		return
+	}
+
+	h.respondWithCart(w, r, cartID)
+}
+
+// HandleGetSessionCart is the session-identified counterpart of
+// HandleGetCart, registered under /cart.
+func (h *Handler) HandleGetSessionCart(w http.ResponseWriter, r *http.Request) {
+	cartID, err := sessionCartID(r)
+	if err != nil {
+		h.writeSessionError(w, err)
+		return
+	}
+
+	h.respondWithCart(w, r, cartID)
+}
+
+func (h *Handler) writeSessionError(w http.ResponseWriter, err error) {
+	if errors.Is(err, ErrMissingSession) {
+		api.ErrorResponse(w, http.StatusUnauthorized, err.Error())
+		return
+	}
+	api.ErrorResponse(w, http.StatusBadRequest, err.Error())
+}
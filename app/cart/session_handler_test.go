@@ -0,0 +1,86 @@
+package cart
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleAddOrUpdateSessionItem(t *testing.T) {
+	t.Run("adds an item to the cart named by SessionHeader", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		handler := newTestHandler(products, newMockCartRepository())
+
+		body := bytes.NewBufferString(`{"product_code":"PROD001","quantity":3}`)
+		req := httptest.NewRequest(http.MethodPost, "/cart/items", body)
+		req.Header.Set(SessionHeader, "9")
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddOrUpdateSessionItem(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var resp Response
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		assert.Equal(t, uint(9), resp.ID)
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, 3, resp.Items[0].Quantity)
+	})
+
+	t.Run("returns 401 when no session is present", func(t *testing.T) {
+		handler := newTestHandler(&MockProductsRepository{products: map[string]models.Product{}}, newMockCartRepository())
+
+		body := bytes.NewBufferString(`{"product_code":"PROD001","quantity":1}`)
+		req := httptest.NewRequest(http.MethodPost, "/cart/items", body)
+		rec := httptest.NewRecorder()
+
+		handler.HandleAddOrUpdateSessionItem(rec, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	})
+}
+
+func TestHandleGetSessionCart(t *testing.T) {
+	t.Run("returns the cart named by SessionHeader", func(t *testing.T) {
+		handler := newTestHandler(&MockProductsRepository{products: map[string]models.Product{}}, newMockCartRepository())
+
+		req := httptest.NewRequest(http.MethodGet, "/cart", nil)
+		req.Header.Set(SessionHeader, "9")
+		rec := httptest.NewRecorder()
+
+		handler.HandleGetSessionCart(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"id":9`)
+	})
+}
+
+func TestHandleRemoveSessionItem(t *testing.T) {
+	t.Run("removes an item from the cart named by SessionHeader", func(t *testing.T) {
+		products := &MockProductsRepository{products: map[string]models.Product{
+			"PROD001": {ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+		}}
+		carts := newMockCartRepository()
+		carts.carts[9] = map[uint]models.CartItem{1: {CartID: 9, ProductID: 1, Price: decimal.NewFromFloat(10), Quantity: 2}}
+		handler := newTestHandler(products, carts)
+
+		req := httptest.NewRequest(http.MethodDelete, "/cart/items/PROD001", nil)
+		req.SetPathValue("code", "PROD001")
+		req.Header.Set(SessionHeader, "9")
+		rec := httptest.NewRecorder()
+
+		handler.HandleRemoveSessionItem(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Body.String(), `"items":null`)
+	})
+}
@@ -0,0 +1,38 @@
+package cart
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionCartID(t *testing.T) {
+	t.Run("trusts SessionHeader directly", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/cart", nil)
+		req.Header.Set(SessionHeader, "42")
+
+		id, err := sessionCartID(req)
+
+		require.NoError(t, err)
+		assert.Equal(t, uint(42), id)
+	})
+
+	t.Run("rejects a non-numeric SessionHeader", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/cart", nil)
+		req.Header.Set(SessionHeader, "not-a-number")
+
+		_, err := sessionCartID(req)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("requires a session when the header is absent", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/cart", nil)
+
+		_, err := sessionCartID(req)
+
+		assert.ErrorIs(t, err, ErrMissingSession)
+	})
+}
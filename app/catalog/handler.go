@@ -1,7 +1,6 @@
 package catalog
 
 import (
-	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,9 +9,19 @@ import (
 	"github.com/mytheresa/go-hiring-challenge/app/api"
 	"github.com/mytheresa/go-hiring-challenge/models"
 	"github.com/shopspring/decimal"
-	"gorm.io/gorm"
 )
 
+// maxSearchLength caps the "search" query parameter to keep the ILIKE
+// pattern GetProducts builds from it small.
+const maxSearchLength = 100
+
+// sortableFields whitelists the field names accepted by the "sort" query
+// parameter; GetProducts maps these to actual columns itself.
+var sortableFields = map[string]bool{
+	"price": true,
+	"code":  true,
+}
+
 type Response struct {
 	Products []ProductDTO `json:"products"`
 	Total    int64        `json:"total"`
@@ -20,6 +29,23 @@ type Response struct {
 	Limit    int          `json:"limit"`
 }
 
+// CursorResponse is returned instead of Response when the request opts into
+// cursor-based pagination by including a (possibly empty) "cursor" query
+// parameter.
+type CursorResponse struct {
+	Data   []ProductDTO   `json:"data"`
+	Cursor CursorEnvelope `json:"cursor"`
+}
+
+// CursorEnvelope carries the cursor the client sent (Self) and the cursors
+// to pass back in for the next/previous page, omitted once there is no such
+// page.
+type CursorEnvelope struct {
+	Self string `json:"self"`
+	Next string `json:"next,omitempty"`
+	Prev string `json:"prev,omitempty"`
+}
+
 type CategoryDTO struct {
 	Code string `json:"code"`
 	Name string `json:"name"`
@@ -45,26 +71,24 @@ type VariantDTO struct {
 }
 
 type CatalogHandler struct {
-	repo models.ProductsRepositoryInterface
+	svc *Service
 }
 
-func NewCatalogHandler(r models.ProductsRepositoryInterface) *CatalogHandler {
-	return &CatalogHandler{
-		repo: r,
-	}
+func NewCatalogHandler(svc *Service) *CatalogHandler {
+	return &CatalogHandler{svc: svc}
 }
 
-func (h *CatalogHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
-	opts, err := parseQueryOptions(r)
+// HandleGet is a ReturnHandler: callers must reach it through StdHandler,
+// which turns the error it returns into the actual response.
+func (h *CatalogHandler) HandleGet(w http.ResponseWriter, r *http.Request) error {
+	opts, cursorMode, err := h.parseQueryOptions(r)
 	if err != nil {
-		api.ErrorResponse(w, http.StatusBadRequest, err.Error())
-		return
+		return &HTTPError{Code: http.StatusBadRequest, Msg: err.Error()}
 	}
 
-	res, total, err := h.repo.GetProducts(r.Context(), opts)
+	res, total, err := h.svc.ListProducts(r.Context(), opts)
 	if err != nil {
-		api.ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return err
 	}
 
 	productDTOs := make([]ProductDTO, len(res))
@@ -83,29 +107,57 @@ func (h *CatalogHandler) HandleGet(w http.ResponseWriter, r *http.Request) {
 		productDTOs[i] = dto
 	}
 
+	if cursorMode {
+		api.OKResponse(w, CursorResponse{
+			Data:   productDTOs,
+			Cursor: h.buildCursorEnvelope(opts, res),
+		})
+		return nil
+	}
+
 	api.OKResponse(w, Response{
 		Products: productDTOs,
 		Total:    total,
 		Offset:   opts.Offset,
 		Limit:    opts.Limit,
 	})
+	return nil
 }
 
-func (h *CatalogHandler) HandleGetByCode(w http.ResponseWriter, r *http.Request) {
+// buildCursorEnvelope derives the next/prev cursors for a cursor-mode page
+// from the rows that page actually returned, so a short last page correctly
+// omits "next" and a first page (no incoming cursor) omits "prev".
+func (h *CatalogHandler) buildCursorEnvelope(opts models.ProductQueryParameters, products []models.Product) CursorEnvelope {
+	envelope := CursorEnvelope{Self: opts.Cursor}
+	if len(products) == 0 {
+		return envelope
+	}
+
+	fingerprint := models.ProductFilterFingerprint(opts)
+	first, last := products[0], products[len(products)-1]
+
+	if len(products) == opts.Limit {
+		envelope.Next = models.EncodeProductCursor(last.ID, last.Code, models.CursorDirNext, fingerprint)
+	}
+	if opts.Cursor != "" {
+		envelope.Prev = models.EncodeProductCursor(first.ID, first.Code, models.CursorDirPrev, fingerprint)
+	}
+
+	return envelope
+}
+
+// HandleGetByCode is a ReturnHandler: callers must reach it through
+// StdHandler, which maps gorm.ErrRecordNotFound to 404 and turns any other
+// error it returns into the actual response.
+func (h *CatalogHandler) HandleGetByCode(w http.ResponseWriter, r *http.Request) error {
 	code := r.PathValue("code")
 	if code == "" {
-		api.ErrorResponse(w, http.StatusBadRequest, "product code is required")
-		return
+		return &HTTPError{Code: http.StatusBadRequest, Msg: "product code is required"}
 	}
 
-	product, err := h.repo.GetProductByCode(r.Context(), code)
+	product, err := h.svc.GetProduct(r.Context(), code)
 	if err != nil {
-		if errors.Is(err, gorm.ErrRecordNotFound) {
-			api.ErrorResponse(w, http.StatusNotFound, "product not found")
-			return
-		}
-		api.ErrorResponse(w, http.StatusInternalServerError, err.Error())
-		return
+		return err
 	}
 
 	// Map variants with price inheritance
@@ -136,9 +188,14 @@ func (h *CatalogHandler) HandleGetByCode(w http.ResponseWriter, r *http.Request)
 	}
 
 	api.OKResponse(w, response)
+	return nil
 }
 
-func parseQueryOptions(r *http.Request) (models.ProductQueryParameters, error) {
+// parseQueryOptions reads the catalog's filter and pagination query
+// parameters. The bool return reports whether the caller opted into
+// cursor-based pagination (a "cursor" query parameter, possibly empty, was
+// present) as opposed to the deprecated offset/limit fallback.
+func (h *CatalogHandler) parseQueryOptions(r *http.Request) (models.ProductQueryParameters, bool, error) {
 	opts := models.ProductQueryParameters{
 		PaginationQueryParameters: models.PaginationQueryParameters{
 			Offset: 0,
@@ -149,7 +206,7 @@ func parseQueryOptions(r *http.Request) (models.ProductQueryParameters, error) {
 	if offsetStr := r.URL.Query().Get("offset"); offsetStr != "" {
 		offset, err := strconv.Atoi(offsetStr)
 		if err != nil || offset < 0 {
-			return opts, fmt.Errorf("invalid offset parameter")
+			return opts, false, fmt.Errorf("invalid offset parameter")
 		}
 		opts.Offset = offset
 	}
@@ -157,15 +214,15 @@ func parseQueryOptions(r *http.Request) (models.ProductQueryParameters, error) {
 	if limitStr := r.URL.Query().Get("limit"); limitStr != "" {
 		limit, err := strconv.Atoi(limitStr)
 		if err != nil || limit < 1 || limit > 100 {
-			return opts, fmt.Errorf("limit must be between 1 and 100")
+			return opts, false, fmt.Errorf("limit must be between 1 and 100")
 		}
 		opts.Limit = limit
 	}
 
 	if category := r.URL.Query().Get("category"); category != "" {
-		categoryNormalized := strings.TrimSpace(strings.ToUpper(category))
-		if !validCategory(categoryNormalized) {
-			return opts, fmt.Errorf("invalid category %q", category)
+		categoryNormalized, err := h.svc.ValidateCategory(r.Context(), category)
+		if err != nil {
+			return opts, false, err
 		}
 		opts.Category = categoryNormalized
 	}
@@ -173,20 +230,68 @@ func parseQueryOptions(r *http.Request) (models.ProductQueryParameters, error) {
 	if priceStr := r.URL.Query().Get("price_less_than"); priceStr != "" {
 		price, err := decimal.NewFromString(priceStr)
 		if err != nil {
-			return opts, fmt.Errorf("invalid price_less_than parameter")
+			return opts, false, fmt.Errorf("invalid price_less_than parameter")
 		}
 		opts.PriceLessThan = &price
 	}
 
-	return opts, nil
+	if sortStr := r.URL.Query().Get("sort"); sortStr != "" {
+		sort, err := parseSort(sortStr)
+		if err != nil {
+			return opts, false, err
+		}
+		opts.Sort = sort
+	}
+
+	if search := r.URL.Query().Get("search"); search != "" {
+		if len(search) > maxSearchLength {
+			return opts, false, fmt.Errorf("search must be at most %d characters", maxSearchLength)
+		}
+		opts.Search = search
+	}
+
+	cursorMode := r.URL.Query().Has("cursor")
+	if cursorMode {
+		if len(opts.Sort) > 0 {
+			return opts, false, fmt.Errorf("sort is not supported with cursor-based pagination")
+		}
+
+		opts.Cursor = r.URL.Query().Get("cursor")
+		if opts.Cursor != "" {
+			cursor, err := models.DecodeProductCursor(opts.Cursor)
+			if err != nil {
+				return opts, false, fmt.Errorf("invalid cursor parameter")
+			}
+			if cursor.Fingerprint != models.ProductFilterFingerprint(opts) {
+				return opts, false, fmt.Errorf("cursor does not match the current filters")
+			}
+		}
+	}
+
+	return opts, cursorMode, nil
 }
 
-func validCategory(category string) bool {
-	allowed := map[string]struct{}{
-		"CLOTHING":    {},
-		"SHOES":       {},
-		"ACCESSORIES": {},
+// parseSort validates a comma-separated "sort" value (e.g. "price,-code")
+// against sortableFields, returning one models.SortField per entry in the
+// order given.
+func parseSort(sortStr string) ([]models.SortField, error) {
+	parts := strings.Split(sortStr, ",")
+	fields := make([]models.SortField, 0, len(parts))
+
+	for _, part := range parts {
+		field := strings.TrimSpace(part)
+		desc := false
+		if strings.HasPrefix(field, "-") {
+			desc = true
+			field = field[1:]
+		}
+
+		if !sortableFields[field] {
+			return nil, fmt.Errorf("invalid sort field %q", field)
+		}
+
+		fields = append(fields, models.SortField{Field: field, Desc: desc})
 	}
-	_, ok := allowed[category]
-	return ok
+
+	return fields, nil
 }
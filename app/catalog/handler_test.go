@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/mytheresa/go-hiring-challenge/models"
@@ -45,6 +46,33 @@ func (m *MockProductsRepository) GetProductByCode(ctx context.Context, code stri
 	return m.productByCode, nil
 }
 
+// MockCategoriesRepository implements CategoriesRepositoryInterface for testing
+// the catalog handler's category validation.
+type MockCategoriesRepository struct {
+	categories []models.Category
+	getAllErr  error
+}
+
+func (m *MockCategoriesRepository) GetAllCategories(ctx context.Context) ([]models.Category, error) {
+	return m.categories, m.getAllErr
+}
+
+func (m *MockCategoriesRepository) CreateCategory(ctx context.Context, category *models.Category) error {
+	m.categories = append(m.categories, *category)
+	return nil
+}
+
+func newTestCatalogHandler(productsRepo models.ProductsRepositoryInterface) *CatalogHandler {
+	catRepo := &MockCategoriesRepository{
+		categories: []models.Category{
+			{Code: "CLOTHING", Name: "Clothing"},
+			{Code: "SHOES", Name: "Shoes"},
+			{Code: "ACCESSORIES", Name: "Accessories"},
+		},
+	}
+	return NewCatalogHandler(NewService(productsRepo, catRepo))
+}
+
 func TestHandleGet(t *testing.T) {
 	t.Run("get all products successfully", func(t *testing.T) {
 		mockRepo := &MockProductsRepository{
@@ -53,11 +81,11 @@ func TestHandleGet(t *testing.T) {
 			getErr:   nil,
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog", nil)
 		rec := httptest.NewRecorder()
 
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 	})
@@ -67,14 +95,15 @@ func TestHandleGet(t *testing.T) {
 			getErr: errors.New("database error"),
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog", nil)
 		rec := httptest.NewRecorder()
 
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
-		assert.Contains(t, rec.Body.String(), "database error")
+		assert.NotContains(t, rec.Body.String(), "database error")
+		assert.Contains(t, rec.Body.String(), "internal server error")
 	})
 
 	t.Run("includes category in response when product has category", func(t *testing.T) {
@@ -93,11 +122,11 @@ func TestHandleGet(t *testing.T) {
 			getErr: nil,
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
 		rec := httptest.NewRecorder()
 
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -133,11 +162,11 @@ func TestHandleGet(t *testing.T) {
 			getErr: nil,
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
 		rec := httptest.NewRecorder()
 
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 
@@ -164,11 +193,11 @@ func TestHandleGet(t *testing.T) {
 			total:    0,
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog", nil)
 		rec := httptest.NewRecorder()
 
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Contains(t, rec.Body.String(), `"offset":0`)
@@ -190,11 +219,11 @@ func TestHandleGet(t *testing.T) {
 			total: 1,
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog?limit=5&offset=0", nil)
 		rec := httptest.NewRecorder()
 
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
@@ -222,30 +251,30 @@ func TestHandleGet(t *testing.T) {
 
 	t.Run("validates limit must be between 1 and 100", func(t *testing.T) {
 		mockRepo := &MockProductsRepository{}
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 
 		// Test limit > 100
 		req := httptest.NewRequest("GET", "/catalog?limit=101", nil)
 		rec := httptest.NewRecorder()
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "limit must be between 1 and 100")
 
 		// Test limit < 1
 		req = httptest.NewRequest("GET", "/catalog?limit=0", nil)
 		rec = httptest.NewRecorder()
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "limit must be between 1 and 100")
 	})
 
 	t.Run("validates offset must be non-negative", func(t *testing.T) {
 		mockRepo := &MockProductsRepository{}
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 
 		req := httptest.NewRequest("GET", "/catalog?offset=-1", nil)
 		rec := httptest.NewRecorder()
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "invalid offset parameter")
@@ -253,31 +282,148 @@ func TestHandleGet(t *testing.T) {
 
 	t.Run("validates category parameter", func(t *testing.T) {
 		mockRepo := &MockProductsRepository{}
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 
 		req := httptest.NewRequest("GET", "/catalog?category=Hats", nil)
 		rec := httptest.NewRecorder()
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		var body struct {
 			Error string `json:"error"`
 		}
 		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
-		require.Equal(t, `invalid category "Hats"`, body.Error)
+		require.Equal(t, `invalid category: "Hats"`, body.Error)
 	})
 
 	t.Run("validates price_less_than parameter", func(t *testing.T) {
 		mockRepo := &MockProductsRepository{}
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 
 		req := httptest.NewRequest("GET", "/catalog?price_less_than=invalid", nil)
 		rec := httptest.NewRecorder()
-		handler.HandleGet(rec, req)
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "invalid price_less_than parameter")
 	})
+
+	t.Run("cursor mode returns the cursor envelope with a next cursor on a full page", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{
+			products: []models.Product{
+				{ID: 1, Code: "PROD001", Price: decimal.NewFromFloat(10)},
+				{ID: 2, Code: "PROD002", Price: decimal.NewFromFloat(20)},
+			},
+			total: 2,
+		}
+
+		handler := newTestCatalogHandler(mockRepo)
+		req := httptest.NewRequest(http.MethodGet, "/catalog?limit=2&cursor=", nil)
+		rec := httptest.NewRecorder()
+
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			Data   []struct{ Code string } `json:"data"`
+			Cursor CursorEnvelope          `json:"cursor"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		require.Len(t, body.Data, 2)
+		assert.Empty(t, body.Cursor.Self)
+		assert.Empty(t, body.Cursor.Prev)
+		assert.NotEmpty(t, body.Cursor.Next)
+	})
+
+	t.Run("rejects a malformed cursor", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?cursor=not-valid-base64!!", nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid cursor parameter")
+	})
+
+	t.Run("rejects a cursor minted under a different filter", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		otherFilter := models.ProductQueryParameters{Category: "SHOES"}
+		tampered := models.EncodeProductCursor(1, "PROD001", models.CursorDirNext, models.ProductFilterFingerprint(otherFilter))
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?cursor="+tampered, nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "cursor does not match the current filters")
+	})
+
+	t.Run("rejects a cursor minted under a different search", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		otherFilter := models.ProductQueryParameters{Search: "shirt"}
+		tampered := models.EncodeProductCursor(1, "PROD001", models.CursorDirNext, models.ProductFilterFingerprint(otherFilter))
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?cursor="+tampered+"&search=pants", nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "cursor does not match the current filters")
+	})
+
+	t.Run("rejects sort combined with cursor-based pagination", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?cursor=&sort=price", nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "sort is not supported with cursor-based pagination")
+	})
+
+	t.Run("accepts a whitelisted multi-field sort", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?sort=price,-code", nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("rejects a non-whitelisted sort field", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?sort=price%3BDROP%20TABLE%20products%3B--", nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid sort field")
+	})
+
+	t.Run("rejects a search query that is too long", func(t *testing.T) {
+		mockRepo := &MockProductsRepository{}
+		handler := newTestCatalogHandler(mockRepo)
+
+		req := httptest.NewRequest(http.MethodGet, "/catalog?search="+strings.Repeat("a", 101), nil)
+		rec := httptest.NewRecorder()
+		StdHandler("GET /catalog", handler.HandleGet).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "search must be at most 100 characters")
+	})
 }
 
 func TestHandleGetByCode(t *testing.T) {
@@ -305,12 +451,12 @@ func TestHandleGetByCode(t *testing.T) {
 			},
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog/PROD001", nil)
 		req.SetPathValue("code", "PROD001")
 		rec := httptest.NewRecorder()
 
-		handler.HandleGetByCode(rec, req)
+		StdHandler("GET /catalog/{code}", handler.HandleGetByCode).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Contains(t, rec.Body.String(), "PROD001")
@@ -334,12 +480,12 @@ func TestHandleGetByCode(t *testing.T) {
 			},
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog/PROD001", nil)
 		req.SetPathValue("code", "PROD001")
 		rec := httptest.NewRecorder()
 
-		handler.HandleGetByCode(rec, req)
+		StdHandler("GET /catalog/{code}", handler.HandleGetByCode).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusOK, rec.Code)
 		assert.Contains(t, rec.Body.String(), `"price":100`)
@@ -350,12 +496,12 @@ func TestHandleGetByCode(t *testing.T) {
 			getByCodeErr: gorm.ErrRecordNotFound,
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog/NOTFOUND", nil)
 		req.SetPathValue("code", "NOTFOUND")
 		rec := httptest.NewRecorder()
 
-		handler.HandleGetByCode(rec, req)
+		StdHandler("GET /catalog/{code}", handler.HandleGetByCode).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusNotFound, rec.Code)
 		assert.Contains(t, rec.Body.String(), "product not found")
@@ -366,26 +512,27 @@ func TestHandleGetByCode(t *testing.T) {
 			getByCodeErr: errors.New("database error"),
 		}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog/PROD001", nil)
 		req.SetPathValue("code", "PROD001")
 		rec := httptest.NewRecorder()
 
-		handler.HandleGetByCode(rec, req)
+		StdHandler("GET /catalog/{code}", handler.HandleGetByCode).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
-		assert.Contains(t, rec.Body.String(), "database error")
+		assert.NotContains(t, rec.Body.String(), "database error")
+		assert.Contains(t, rec.Body.String(), "internal server error")
 	})
 
 	t.Run("returns error when code is empty", func(t *testing.T) {
 		mockRepo := &MockProductsRepository{}
 
-		handler := NewCatalogHandler(mockRepo)
+		handler := newTestCatalogHandler(mockRepo)
 		req := httptest.NewRequest("GET", "/catalog/", nil)
 		// Not setting path value simulates empty code
 		rec := httptest.NewRecorder()
 
-		handler.HandleGetByCode(rec, req)
+		StdHandler("GET /catalog/{code}", handler.HandleGetByCode).ServeHTTP(rec, req)
 
 		assert.Equal(t, http.StatusBadRequest, rec.Code)
 		assert.Contains(t, rec.Body.String(), "product code is required")
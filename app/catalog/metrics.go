@@ -0,0 +1,76 @@
+package catalog
+
+import (
+	"sync"
+	"time"
+)
+
+// latencyBucketsMs are the upper bounds, in milliseconds, of the StdHandler
+// latency histogram; a request slower than the last bound falls into the
+// final overflow bucket. There's no metrics client vendored in this repo, so
+// this is a minimal hand-rolled stand-in.
+var latencyBucketsMs = []int64{10, 50, 100, 250, 500, 1000, 5000}
+
+// routeMetrics accumulates, per route, a request count by status class and a
+// latency histogram.
+type routeMetrics struct {
+	mu        sync.Mutex
+	counts    map[string]int64
+	latencies map[string][]int64
+}
+
+func newRouteMetrics() *routeMetrics {
+	return &routeMetrics{
+		counts:    make(map[string]int64),
+		latencies: make(map[string][]int64),
+	}
+}
+
+func (m *routeMetrics) record(route string, status int, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.counts[route+":"+statusClass(status)]++
+
+	buckets := m.latencies[route]
+	if buckets == nil {
+		buckets = make([]int64, len(latencyBucketsMs)+1)
+		m.latencies[route] = buckets
+	}
+
+	ms := d.Milliseconds()
+	for i, upperBound := range latencyBucketsMs {
+		if ms <= upperBound {
+			buckets[i]++
+			return
+		}
+	}
+	buckets[len(buckets)-1]++
+}
+
+// Count returns how many StdHandler requests route has recorded with the
+// given status (e.g. http.StatusOK), grouped by status class (2xx, 4xx, ...).
+func (m *routeMetrics) Count(route string, status int) int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.counts[route+":"+statusClass(status)]
+}
+
+func statusClass(status int) string {
+	switch status / 100 {
+	case 2:
+		return "2xx"
+	case 3:
+		return "3xx"
+	case 4:
+		return "4xx"
+	case 5:
+		return "5xx"
+	default:
+		return "other"
+	}
+}
+
+// metrics collects request counts and latencies for every route StdHandler
+// wraps.
+var metrics = newRouteMetrics()
@@ -0,0 +1,95 @@
+package catalog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mytheresa/go-hiring-challenge/models"
+)
+
+// categoryCacheTTL bounds how long the category allowlist used to validate
+// the catalog's "category" filter is trusted before being refreshed from
+// CategoriesRepositoryInterface.
+const categoryCacheTTL = 30 * time.Second
+
+// ErrInvalidCategory is returned by ValidateCategory when code doesn't name
+// a known category.
+var ErrInvalidCategory = errors.New("invalid category")
+
+// Service holds the catalog business logic shared by the HTTP handler and
+// the gRPC CatalogServer, so both transports read products - and validate
+// the "category" filter - the same way.
+type Service struct {
+	repo    models.ProductsRepositoryInterface
+	catRepo models.CategoriesRepositoryInterface
+
+	categoryCacheMu      sync.Mutex
+	categoryCache        map[string]struct{}
+	categoryCacheExpires time.Time
+}
+
+func NewService(repo models.ProductsRepositoryInterface, catRepo models.CategoriesRepositoryInterface) *Service {
+	return &Service{repo: repo, catRepo: catRepo}
+}
+
+func (s *Service) ListProducts(ctx context.Context, opts models.ProductQueryParameters) ([]models.Product, int64, error) {
+	return s.repo.GetProducts(ctx, opts)
+}
+
+func (s *Service) GetProduct(ctx context.Context, code string) (*models.Product, error) {
+	return s.repo.GetProductByCode(ctx, code)
+}
+
+// ValidateCategory normalizes code (trimmed, upper-cased) and checks it
+// against an in-memory cache of categories known to
+// CategoriesRepositoryInterface, refreshing the cache on TTL expiry or on a
+// miss so categories created via POST /categories become filterable without
+// a restart. It returns the normalized code on success, or an error if code
+// doesn't name a known category.
+func (s *Service) ValidateCategory(ctx context.Context, code string) (string, error) {
+	normalized := strings.TrimSpace(strings.ToUpper(code))
+
+	s.categoryCacheMu.Lock()
+	cache := s.categoryCache
+	fresh := time.Now().Before(s.categoryCacheExpires)
+	s.categoryCacheMu.Unlock()
+
+	if fresh {
+		if _, ok := cache[normalized]; ok {
+			return normalized, nil
+		}
+	}
+
+	cache, err := s.refreshCategoryCache(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to validate category: %w", err)
+	}
+
+	if _, ok := cache[normalized]; !ok {
+		return "", fmt.Errorf("%w: %q", ErrInvalidCategory, code)
+	}
+	return normalized, nil
+}
+
+func (s *Service) refreshCategoryCache(ctx context.Context) (map[string]struct{}, error) {
+	cats, err := s.catRepo.GetAllCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := make(map[string]struct{}, len(cats))
+	for _, c := range cats {
+		cache[strings.ToUpper(c.Code)] = struct{}{}
+	}
+
+	s.categoryCacheMu.Lock()
+	s.categoryCache = cache
+	s.categoryCacheExpires = time.Now().Add(categoryCacheTTL)
+	s.categoryCacheMu.Unlock()
+
+	return cache, nil
+}
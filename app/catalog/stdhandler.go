@@ -0,0 +1,104 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/mytheresa/go-hiring-challenge/app/api"
+	"gorm.io/gorm"
+)
+
+// ReturnHandler is an HTTP handler that reports failure by returning an
+// error instead of writing an error response itself, tsweb-style. StdHandler
+// adapts it into an http.Handler, so a ReturnHandler only ever needs to
+// write the success case.
+type ReturnHandler func(w http.ResponseWriter, r *http.Request) error
+
+// HTTPError is the error a ReturnHandler returns when it wants to control
+// the status code and message sent to the client directly. Err, if set, is
+// the underlying cause; StdHandler logs it but never sends it to the client.
+type HTTPError struct {
+	Code int
+	Msg  string
+	Err  error
+}
+
+func (e *HTTPError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s", e.Msg, e.Err)
+	}
+	return e.Msg
+}
+
+func (e *HTTPError) Unwrap() error {
+	return e.Err
+}
+
+// statusCapture wraps a ResponseWriter to record the status code a
+// ReturnHandler writes on success, since StdHandler otherwise has no way to
+// learn it for metrics.
+type statusCapture struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusCapture) WriteHeader(code int) {
+	s.status = code
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// StdHandler adapts h into an http.Handler registered under route: it writes
+// the response for any error h returns - mapping gorm.ErrRecordNotFound to
+// 404 centrally rather than in every handler that can return it - recovers
+// a panic in h into a 500, and records route's request count and latency in
+// metrics.
+func StdHandler(route string, h ReturnHandler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rec := &statusCapture{ResponseWriter: w, status: http.StatusOK}
+		start := time.Now()
+
+		defer func() {
+			metrics.record(route, rec.status, time.Since(start))
+		}()
+
+		defer func() {
+			if p := recover(); p != nil {
+				api.LoggerFromContext(r.Context()).Error("handler panicked",
+					"route", route,
+					"panic", p,
+				)
+				writeHandlerError(rec, r, fmt.Errorf("panic: %v", p))
+			}
+		}()
+
+		if err := h(rec, r); err != nil {
+			writeHandlerError(rec, r, err)
+		}
+	})
+}
+
+// writeHandlerError maps err to the response StdHandler sends: an *HTTPError
+// is trusted as-is, gorm.ErrRecordNotFound becomes a 404, and everything
+// else falls back to api.WriteRepositoryError's context-aware 504/499/500
+// mapping.
+func writeHandlerError(w http.ResponseWriter, r *http.Request, err error) {
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		if httpErr.Err != nil {
+			api.LoggerFromContext(r.Context()).Error("handler returned an error",
+				"error", httpErr.Err,
+			)
+		}
+		api.ErrorResponse(w, httpErr.Code, httpErr.Msg)
+		return
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		api.ErrorResponse(w, http.StatusNotFound, "product not found")
+		return
+	}
+
+	api.WriteRepositoryError(w, r, err)
+}
@@ -0,0 +1,93 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+func TestStdHandler(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/catalog", nil)
+
+	t.Run("writes the body and status an *HTTPError carries", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h := StdHandler("GET /catalog", func(w http.ResponseWriter, r *http.Request) error {
+			return &HTTPError{Code: http.StatusBadRequest, Msg: "invalid offset parameter"}
+		})
+
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Contains(t, rec.Body.String(), "invalid offset parameter")
+	})
+
+	t.Run("maps gorm.ErrRecordNotFound to 404 without a handler special-case", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h := StdHandler("GET /catalog/{code}", func(w http.ResponseWriter, r *http.Request) error {
+			return gorm.ErrRecordNotFound
+		})
+
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+		assert.Contains(t, rec.Body.String(), "product not found")
+	})
+
+	t.Run("maps an unrecognized error to 500 without leaking its text", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h := StdHandler("GET /catalog", func(w http.ResponseWriter, r *http.Request) error {
+			return errors.New("database exploded")
+		})
+
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "database exploded")
+		assert.Contains(t, rec.Body.String(), "internal server error")
+	})
+
+	t.Run("recovers a panic into a 500 without leaking its text", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		h := StdHandler("GET /catalog", func(w http.ResponseWriter, r *http.Request) error {
+			panic("boom")
+		})
+
+		require.NotPanics(t, func() {
+			h.ServeHTTP(rec, req)
+		})
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+		assert.NotContains(t, rec.Body.String(), "boom")
+		assert.Contains(t, rec.Body.String(), "internal server error")
+	})
+
+	t.Run("leaves a success response untouched and records its real status", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		before := metrics.Count("GET /catalog", http.StatusOK)
+
+		h := StdHandler("GET /catalog", func(w http.ResponseWriter, r *http.Request) error {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"ok":true}`))
+			return nil
+		})
+
+		h.ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		var body struct {
+			OK bool `json:"ok"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+		assert.True(t, body.OK)
+
+		assert.Equal(t, before+1, metrics.Count("GET /catalog", http.StatusOK))
+	})
+}
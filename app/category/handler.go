@@ -33,7 +33,7 @@ func NewCategoriesHandler(repo models.CategoriesRepositoryInterface) *Categories
 func (h *CategoriesHandler) HandleGetAll(w http.ResponseWriter, r *http.Request) {
 	categories, err := h.repo.GetAllCategories(r.Context())
 	if err != nil {
-		api.ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		api.WriteRepositoryError(w, r, err)
 		return
 	}
 
@@ -67,7 +67,7 @@ func (h *CategoriesHandler) HandleCreate(w http.ResponseWriter, r *http.Request)
 	}
 
 	if err := h.repo.CreateCategory(r.Context(), category); err != nil {
-		api.ErrorResponse(w, http.StatusInternalServerError, err.Error())
+		api.WriteRepositoryError(w, r, err)
 		return
 	}
 
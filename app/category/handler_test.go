@@ -101,7 +101,8 @@ func TestHandleGetAll(t *testing.T) {
 		handler.HandleGetAll(rec, req)
 
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
-		assert.Contains(t, rec.Body.String(), "database error")
+		assert.NotContains(t, rec.Body.String(), "database error")
+		assert.Contains(t, rec.Body.String(), "internal server error")
 	})
 }
 
@@ -184,6 +185,7 @@ func TestHandleCreate(t *testing.T) {
 		handler.HandleCreate(rec, req)
 
 		assert.Equal(t, http.StatusInternalServerError, rec.Code)
-		assert.Contains(t, rec.Body.String(), "database error")
+		assert.NotContains(t, rec.Body.String(), "database error")
+		assert.Contains(t, rec.Body.String(), "internal server error")
 	})
 }
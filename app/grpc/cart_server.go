@@ -0,0 +1,60 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mytheresa/go-hiring-challenge/app/cart"
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/cartpb"
+)
+
+// CartServer implements cartpb.CartServiceServer on top of the same
+// cart.Service the HTTP cart handler uses.
+type CartServer struct {
+	cartpb.UnimplementedCartServiceServer
+	service *cart.Service
+}
+
+func NewCartServer(service *cart.Service) *CartServer {
+	return &CartServer{service: service}
+}
+
+func (s *CartServer) AddOrUpdateItem(ctx context.Context, req *cartpb.AddOrUpdateItemRequest) (*cartpb.CartResponse, error) {
+	if err := s.service.AddOrUpdateItem(ctx, uint(req.GetCartId()), req.GetProductCode(), int(req.GetQuantity())); err != nil {
+		return nil, statusError(err)
+	}
+	return s.getCart(ctx, uint(req.GetCartId()))
+}
+
+func (s *CartServer) RemoveItem(ctx context.Context, req *cartpb.RemoveItemRequest) (*cartpb.CartResponse, error) {
+	if err := s.service.RemoveItem(ctx, uint(req.GetCartId()), req.GetProductCode()); err != nil {
+		return nil, statusError(err)
+	}
+	return s.getCart(ctx, uint(req.GetCartId()))
+}
+
+func (s *CartServer) GetCart(ctx context.Context, req *cartpb.GetCartRequest) (*cartpb.CartResponse, error) {
+	return s.getCart(ctx, uint(req.GetCartId()))
+}
+
+func (s *CartServer) getCart(ctx context.Context, cartID uint) (*cartpb.CartResponse, error) {
+	c, err := s.service.GetCart(ctx, cartID)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	items := make([]*cartpb.Item, len(c.Items))
+	for i, it := range c.Items {
+		items[i] = &cartpb.Item{
+			ProductCode: it.ProductCode,
+			Quantity:    int32(it.Quantity),
+			Price:       it.Price.String(),
+			Subtotal:    it.Subtotal.String(),
+		}
+	}
+
+	return &cartpb.CartResponse{
+		Id:    uint64(c.ID),
+		Items: items,
+		Total: c.Total.String(),
+	}, nil
+}
@@ -0,0 +1,155 @@
+// Hand-maintained stand-in for protoc-gen-go output, mirroring
+// api/proto/cart.proto field-for-field. Run `make proto` to regenerate this
+// from source once protoc-gen-go/protoc-gen-go-grpc are available; keep it
+// in sync by hand until then.
+//
+// Note: these types implement the legacy github.com/golang/protobuf
+// proto.Message interface (Reset/String/ProtoMessage), not the
+// protoreflect-based one real protoc-gen-go output would produce - replacing
+// this file with real codegen is required before relying on wire-format
+// compatibility with other protobuf implementations.
+
+package cartpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type Item struct {
+	ProductCode string `protobuf:"bytes,1,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	Quantity    int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price       string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+	Subtotal    string `protobuf:"bytes,4,opt,name=subtotal,proto3" json:"subtotal,omitempty"`
+}
+
+func (x *Item) Reset()         { *x = Item{} }
+func (x *Item) String() string { return proto.CompactTextString(x) }
+func (*Item) ProtoMessage()    {}
+
+func (x *Item) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *Item) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Item) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Item) GetSubtotal() string {
+	if x != nil {
+		return x.Subtotal
+	}
+	return ""
+}
+
+type AddOrUpdateItemRequest struct {
+	CartId      uint64 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductCode string `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+	Quantity    int32  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *AddOrUpdateItemRequest) Reset()         { *x = AddOrUpdateItemRequest{} }
+func (x *AddOrUpdateItemRequest) String() string { return proto.CompactTextString(x) }
+func (*AddOrUpdateItemRequest) ProtoMessage()    {}
+
+func (x *AddOrUpdateItemRequest) GetCartId() uint64 {
+	if x != nil {
+		return x.CartId
+	}
+	return 0
+}
+
+func (x *AddOrUpdateItemRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *AddOrUpdateItemRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type RemoveItemRequest struct {
+	CartId      uint64 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+	ProductCode string `protobuf:"bytes,2,opt,name=product_code,json=productCode,proto3" json:"product_code,omitempty"`
+}
+
+func (x *RemoveItemRequest) Reset()         { *x = RemoveItemRequest{} }
+func (x *RemoveItemRequest) String() string { return proto.CompactTextString(x) }
+func (*RemoveItemRequest) ProtoMessage()    {}
+
+func (x *RemoveItemRequest) GetCartId() uint64 {
+	if x != nil {
+		return x.CartId
+	}
+	return 0
+}
+
+func (x *RemoveItemRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+type GetCartRequest struct {
+	CartId uint64 `protobuf:"varint,1,opt,name=cart_id,json=cartId,proto3" json:"cart_id,omitempty"`
+}
+
+func (x *GetCartRequest) Reset()         { *x = GetCartRequest{} }
+func (x *GetCartRequest) String() string { return proto.CompactTextString(x) }
+func (*GetCartRequest) ProtoMessage()    {}
+
+func (x *GetCartRequest) GetCartId() uint64 {
+	if x != nil {
+		return x.CartId
+	}
+	return 0
+}
+
+type CartResponse struct {
+	Id    uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Items []*Item `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Total string  `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *CartResponse) Reset()         { *x = CartResponse{} }
+func (x *CartResponse) String() string { return proto.CompactTextString(x) }
+func (*CartResponse) ProtoMessage()    {}
+
+func (x *CartResponse) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CartResponse) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *CartResponse) GetTotal() string {
+	if x != nil {
+		return x.Total
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+// Hand-maintained stand-in for protoc-gen-go-grpc output, mirroring
+// api/proto/cart.proto's CartService. Run `make proto` to regenerate this
+// from source once protoc-gen-go-grpc is available; keep it in sync by hand
+// until then.
+
+package cartpb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+const (
+	CartService_AddOrUpdateItem_FullMethodName = "/cart.CartService/AddOrUpdateItem"
+	CartService_RemoveItem_FullMethodName      = "/cart.CartService/RemoveItem"
+	CartService_GetCart_FullMethodName         = "/cart.CartService/GetCart"
+)
+
+// CartServiceClient is the client API for CartService.
+type CartServiceClient interface {
+	AddOrUpdateItem(ctx context.Context, in *AddOrUpdateItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*CartResponse, error)
+	GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartResponse, error)
+}
+
+type cartServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCartServiceClient(cc grpc.ClientConnInterface) CartServiceClient {
+	return &cartServiceClient{cc}
+}
+
+func (c *cartServiceClient) AddOrUpdateItem(ctx context.Context, in *AddOrUpdateItemRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	if err := c.cc.Invoke(ctx, CartService_AddOrUpdateItem_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) RemoveItem(ctx context.Context, in *RemoveItemRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	if err := c.cc.Invoke(ctx, CartService_RemoveItem_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) GetCart(ctx context.Context, in *GetCartRequest, opts ...grpc.CallOption) (*CartResponse, error) {
+	out := new(CartResponse)
+	if err := c.cc.Invoke(ctx, CartService_GetCart_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CartServiceServer is the server API for CartService.
+type CartServiceServer interface {
+	AddOrUpdateItem(context.Context, *AddOrUpdateItemRequest) (*CartResponse, error)
+	RemoveItem(context.Context, *RemoveItemRequest) (*CartResponse, error)
+	GetCart(context.Context, *GetCartRequest) (*CartResponse, error)
+	mustEmbedUnimplementedCartServiceServer()
+}
+
+// UnimplementedCartServiceServer must be embedded to have forward compatible
+// implementations.
+type UnimplementedCartServiceServer struct{}
+
+func (UnimplementedCartServiceServer) AddOrUpdateItem(context.Context, *AddOrUpdateItemRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddOrUpdateItem not implemented")
+}
+
+func (UnimplementedCartServiceServer) RemoveItem(context.Context, *RemoveItemRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveItem not implemented")
+}
+
+func (UnimplementedCartServiceServer) GetCart(context.Context, *GetCartRequest) (*CartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCart not implemented")
+}
+
+func (UnimplementedCartServiceServer) mustEmbedUnimplementedCartServiceServer() {}
+
+func RegisterCartServiceServer(s grpc.ServiceRegistrar, srv CartServiceServer) {
+	s.RegisterService(&CartService_ServiceDesc, srv)
+}
+
+func _CartService_AddOrUpdateItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddOrUpdateItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).AddOrUpdateItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: CartService_AddOrUpdateItem_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).AddOrUpdateItem(ctx, req.(*AddOrUpdateItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_RemoveItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).RemoveItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: CartService_RemoveItem_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).RemoveItem(ctx, req.(*RemoveItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_GetCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).GetCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: CartService_GetCart_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).GetCart(ctx, req.(*GetCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CartService_ServiceDesc is the grpc.ServiceDesc for CartService.
+var CartService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cart.CartService",
+	HandlerType: (*CartServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "AddOrUpdateItem", Handler: _CartService_AddOrUpdateItem_Handler},
+		{MethodName: "RemoveItem", Handler: _CartService_RemoveItem_Handler},
+		{MethodName: "GetCart", Handler: _CartService_GetCart_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/cart.proto",
+}
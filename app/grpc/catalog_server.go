@@ -0,0 +1,118 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/catalogpb"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultListLimit mirrors the default CatalogHandler applies when an HTTP
+// caller omits "limit"; proto3 leaves an unset Limit at 0, which would
+// otherwise turn into an unintended "LIMIT 0" at the repository.
+const defaultListLimit = 10
+
+// CatalogServer implements catalogpb.CatalogServiceServer on top of the same
+// catalog.Service the HTTP CatalogHandler uses, so both transports share
+// business logic.
+type CatalogServer struct {
+	catalogpb.UnimplementedCatalogServiceServer
+	svc *catalog.Service
+}
+
+func NewCatalogServer(svc *catalog.Service) *CatalogServer {
+	return &CatalogServer{svc: svc}
+}
+
+func (s *CatalogServer) ListProducts(ctx context.Context, req *catalogpb.ListProductsRequest) (*catalogpb.ListProductsResponse, error) {
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = defaultListLimit
+	}
+
+	opts := models.ProductQueryParameters{
+		PaginationQueryParameters: models.PaginationQueryParameters{
+			Offset: int(req.GetOffset()),
+			Limit:  limit,
+		},
+	}
+
+	if req.GetCategory() != "" {
+		category, err := s.svc.ValidateCategory(ctx, req.GetCategory())
+		if err != nil {
+			return nil, statusError(err)
+		}
+		opts.Category = category
+	}
+
+	if req.GetPriceLessThan() != "" {
+		price, err := decimal.NewFromString(req.GetPriceLessThan())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid price_less_than")
+		}
+		opts.PriceLessThan = &price
+	}
+
+	products, total, err := s.svc.ListProducts(ctx, opts)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	pbProducts := make([]*catalogpb.Product, len(products))
+	for i, p := range products {
+		pbProducts[i] = toPBProduct(p)
+	}
+
+	return &catalogpb.ListProductsResponse{
+		Products: pbProducts,
+		Total:    total,
+		Offset:   int32(opts.Offset),
+		Limit:    int32(opts.Limit),
+	}, nil
+}
+
+func (s *CatalogServer) GetProduct(ctx context.Context, req *catalogpb.GetProductRequest) (*catalogpb.GetProductResponse, error) {
+	product, err := s.svc.GetProduct(ctx, req.GetCode())
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	variants := make([]*catalogpb.Variant, len(product.Variants))
+	for i, v := range product.Variants {
+		price := v.Price
+		if price.IsZero() {
+			price = product.Price
+		}
+		variants[i] = &catalogpb.Variant{
+			Name:  v.Name,
+			Sku:   v.SKU,
+			Price: price.String(),
+		}
+	}
+
+	resp := &catalogpb.GetProductResponse{
+		Code:     product.Code,
+		Price:    product.Price.String(),
+		Variants: variants,
+	}
+	if product.Category != nil {
+		resp.Category = &catalogpb.Category{Code: product.Category.Code, Name: product.Category.Name}
+	}
+
+	return resp, nil
+}
+
+func toPBProduct(p models.Product) *catalogpb.Product {
+	pb := &catalogpb.Product{
+		Code:  p.Code,
+		Price: p.Price.String(),
+	}
+	if p.Category != nil {
+		pb.Category = &catalogpb.Category{Code: p.Category.Code, Name: p.Category.Name}
+	}
+	return pb
+}
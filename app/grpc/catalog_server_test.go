@@ -0,0 +1,163 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/catalogpb"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gorm.io/gorm"
+)
+
+// mockProductsRepository implements models.ProductsRepositoryInterface for
+// exercising CatalogServer end-to-end over a real grpc.ClientConn, without a
+// database.
+type mockProductsRepository struct {
+	products      []models.Product
+	total         int64
+	productByCode *models.Product
+	getByCodeErr  error
+}
+
+func (m *mockProductsRepository) GetAllProducts(ctx context.Context) ([]models.Product, error) {
+	return m.products, nil
+}
+
+func (m *mockProductsRepository) GetProducts(ctx context.Context, opts models.ProductQueryParameters) ([]models.Product, int64, error) {
+	return m.products, m.total, nil
+}
+
+func (m *mockProductsRepository) GetProductByCode(ctx context.Context, code string) (*models.Product, error) {
+	if m.getByCodeErr != nil {
+		return nil, m.getByCodeErr
+	}
+	return m.productByCode, nil
+}
+
+// mockCategoriesRepository implements models.CategoriesRepositoryInterface
+// for exercising CatalogServer's category validation without a database.
+type mockCategoriesRepository struct {
+	categories []models.Category
+}
+
+func (m *mockCategoriesRepository) GetAllCategories(ctx context.Context) ([]models.Category, error) {
+	return m.categories, nil
+}
+
+func (m *mockCategoriesRepository) CreateCategory(ctx context.Context, category *models.Category) error {
+	m.categories = append(m.categories, *category)
+	return nil
+}
+
+// dialCatalogService starts CatalogServer on an in-memory bufconn listener
+// and returns a client connected to it, tearing both down on test cleanup.
+func dialCatalogService(t *testing.T, repo models.ProductsRepositoryInterface) catalogpb.CatalogServiceClient {
+	t.Helper()
+
+	catRepo := &mockCategoriesRepository{
+		categories: []models.Category{{Code: "SHOES", Name: "Shoes"}},
+	}
+
+	const bufSize = 1024 * 1024
+	lis := bufconn.Listen(bufSize)
+
+	srv := grpc.NewServer()
+	catalogpb.RegisterCatalogServiceServer(srv, NewCatalogServer(catalog.NewService(repo, catRepo)))
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return catalogpb.NewCatalogServiceClient(conn)
+}
+
+func TestCatalogServer_ListProducts(t *testing.T) {
+	price := decimal.NewFromFloat(19.99)
+	repo := &mockProductsRepository{
+		products: []models.Product{
+			{Code: "SKU-1", Price: price, Category: &models.Category{Code: "SHOES", Name: "Shoes"}},
+		},
+		total: 1,
+	}
+
+	client := dialCatalogService(t, repo)
+
+	resp, err := client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, resp.GetProducts(), 1)
+	require.Equal(t, "SKU-1", resp.GetProducts()[0].GetCode())
+	require.Equal(t, "19.99", resp.GetProducts()[0].GetPrice())
+	require.Equal(t, int64(1), resp.GetTotal())
+}
+
+func TestCatalogServer_ListProducts_DefaultsOmittedLimit(t *testing.T) {
+	repo := &mockProductsRepository{
+		products: []models.Product{{Code: "SKU-1", Price: decimal.NewFromFloat(19.99)}},
+		total:    1,
+	}
+
+	client := dialCatalogService(t, repo)
+
+	resp, err := client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int32(defaultListLimit), resp.GetLimit())
+	require.Len(t, resp.GetProducts(), 1)
+}
+
+func TestCatalogServer_ListProducts_RejectsUnknownCategory(t *testing.T) {
+	repo := &mockProductsRepository{}
+
+	client := dialCatalogService(t, repo)
+
+	_, err := client.ListProducts(context.Background(), &catalogpb.ListProductsRequest{Category: "not-a-category"})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCatalogServer_GetProduct_NotFound(t *testing.T) {
+	repo := &mockProductsRepository{getByCodeErr: gorm.ErrRecordNotFound}
+
+	client := dialCatalogService(t, repo)
+
+	_, err := client.GetProduct(context.Background(), &catalogpb.GetProductRequest{Code: "missing"})
+	require.Error(t, err)
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCatalogServer_GetProduct(t *testing.T) {
+	price := decimal.NewFromFloat(49.5)
+	repo := &mockProductsRepository{
+		productByCode: &models.Product{
+			Code:     "SKU-2",
+			Price:    price,
+			Variants: []models.Variant{{Name: "Small", SKU: "SKU-2-S"}},
+		},
+	}
+
+	client := dialCatalogService(t, repo)
+
+	resp, err := client.GetProduct(context.Background(), &catalogpb.GetProductRequest{Code: "SKU-2"})
+	require.NoError(t, err)
+	require.Equal(t, "SKU-2", resp.GetCode())
+	require.Len(t, resp.GetVariants(), 1)
+	require.Equal(t, "49.5", resp.GetVariants()[0].GetPrice())
+}
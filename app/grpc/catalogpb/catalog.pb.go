@@ -0,0 +1,235 @@
+// Hand-maintained stand-in for protoc-gen-go output, mirroring
+// api/proto/catalog.proto field-for-field. Run `make proto` to regenerate
+// this from source once protoc-gen-go/protoc-gen-go-grpc are available; keep
+// it in sync by hand until then.
+//
+// Note: these types implement the legacy github.com/golang/protobuf
+// proto.Message interface (Reset/String/ProtoMessage), not the
+// protoreflect-based one real protoc-gen-go output would produce - replacing
+// this file with real codegen is required before relying on wire-format
+// compatibility with other protobuf implementations.
+
+package catalogpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type Category struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Category) Reset()         { *x = Category{} }
+func (x *Category) String() string { return proto.CompactTextString(x) }
+func (*Category) ProtoMessage()    {}
+
+func (x *Category) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Variant struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Sku  string `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// Price is a fixed-precision decimal string (e.g. "19.99") so clients
+	// never lose precision to float64 rounding.
+	Price string `protobuf:"bytes,3,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *Variant) Reset()         { *x = Variant{} }
+func (x *Variant) String() string { return proto.CompactTextString(x) }
+func (*Variant) ProtoMessage()    {}
+
+func (x *Variant) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Variant) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Variant) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+type Product struct {
+	Code     string    `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Price    string    `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Category *Category `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *Product) Reset()         { *x = Product{} }
+func (x *Product) String() string { return proto.CompactTextString(x) }
+func (*Product) ProtoMessage()    {}
+
+func (x *Product) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Product) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Product) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	Category      string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	PriceLessThan string `protobuf:"bytes,2,opt,name=price_less_than,json=priceLessThan,proto3" json:"price_less_than,omitempty"`
+	Offset        int32  `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListProductsRequest) Reset()         { *x = ListProductsRequest{} }
+func (x *ListProductsRequest) String() string { return proto.CompactTextString(x) }
+func (*ListProductsRequest) ProtoMessage()    {}
+
+func (x *ListProductsRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPriceLessThan() string {
+	if x != nil {
+		return x.PriceLessThan
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListProductsResponse struct {
+	Products []*Product `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total    int64      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Offset   int32      `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit    int32      `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListProductsResponse) Reset()         { *x = ListProductsResponse{} }
+func (x *ListProductsResponse) String() string { return proto.CompactTextString(x) }
+func (*ListProductsResponse) ProtoMessage()    {}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetProductRequest struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *GetProductRequest) Reset()         { *x = GetProductRequest{} }
+func (x *GetProductRequest) String() string { return proto.CompactTextString(x) }
+func (*GetProductRequest) ProtoMessage()    {}
+
+func (x *GetProductRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type GetProductResponse struct {
+	Code     string     `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Price    string     `protobuf:"bytes,2,opt,name=price,proto3" json:"price,omitempty"`
+	Category *Category  `protobuf:"bytes,3,opt,name=category,proto3" json:"category,omitempty"`
+	Variants []*Variant `protobuf:"bytes,4,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *GetProductResponse) Reset()         { *x = GetProductResponse{} }
+func (x *GetProductResponse) String() string { return proto.CompactTextString(x) }
+func (*GetProductResponse) ProtoMessage()    {}
+
+func (x *GetProductResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *GetProductResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GetProductResponse) GetVariants() []*Variant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/categoriespb"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CategoriesServer implements categoriespb.CategoriesServiceServer on top of
+// the same CategoriesRepositoryInterface the HTTP CategoriesHandler uses.
+type CategoriesServer struct {
+	categoriespb.UnimplementedCategoriesServiceServer
+	repo models.CategoriesRepositoryInterface
+}
+
+func NewCategoriesServer(repo models.CategoriesRepositoryInterface) *CategoriesServer {
+	return &CategoriesServer{repo: repo}
+}
+
+func (s *CategoriesServer) ListCategories(ctx context.Context, _ *categoriespb.ListCategoriesRequest) (*categoriespb.ListCategoriesResponse, error) {
+	cats, err := s.repo.GetAllCategories(ctx)
+	if err != nil {
+		return nil, statusError(err)
+	}
+
+	resp := &categoriespb.ListCategoriesResponse{
+		Categories: make([]*categoriespb.Category, len(cats)),
+	}
+	for i, c := range cats {
+		resp.Categories[i] = &categoriespb.Category{Code: c.Code, Name: c.Name}
+	}
+	return resp, nil
+}
+
+func (s *CategoriesServer) CreateCategory(ctx context.Context, req *categoriespb.CreateCategoryRequest) (*categoriespb.CreateCategoryResponse, error) {
+	if req.GetCode() == "" || req.GetName() == "" {
+		return nil, status.Error(codes.InvalidArgument, "code and name are required")
+	}
+
+	category := &models.Category{Code: req.GetCode(), Name: req.GetName()}
+	if err := s.repo.CreateCategory(ctx, category); err != nil {
+		return nil, statusError(err)
+	}
+	return &categoriespb.CreateCategoryResponse{
+		Category: &categoriespb.Category{Code: category.Code, Name: category.Name},
+	}, nil
+}
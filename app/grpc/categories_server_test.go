@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/categoriespb"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// dialCategoriesService starts CategoriesServer on an in-memory bufconn
+// listener and returns a client connected to it, tearing both down on test
+// cleanup.
+func dialCategoriesService(t *testing.T, repo models.CategoriesRepositoryInterface) categoriespb.CategoriesServiceClient {
+	t.Helper()
+
+	const bufSize = 1024 * 1024
+	lis := bufconn.Listen(bufSize)
+
+	srv := grpc.NewServer()
+	categoriespb.RegisterCategoriesServiceServer(srv, NewCategoriesServer(repo))
+
+	go func() {
+		_ = srv.Serve(lis)
+	}()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return categoriespb.NewCategoriesServiceClient(conn)
+}
+
+func TestCategoriesServer_CreateCategory_RejectsEmptyFields(t *testing.T) {
+	client := dialCategoriesService(t, &mockCategoriesRepository{})
+
+	_, err := client.CreateCategory(context.Background(), &categoriespb.CreateCategoryRequest{Code: "", Name: "Shoes"})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = client.CreateCategory(context.Background(), &categoriespb.CreateCategoryRequest{Code: "SHOES", Name: ""})
+	require.Error(t, err)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,98 @@
+// Hand-maintained stand-in for protoc-gen-go output, mirroring
+// api/proto/categories.proto field-for-field. Run `make proto` to regenerate
+// this from source once protoc-gen-go/protoc-gen-go-grpc are available; keep
+// it in sync by hand until then.
+//
+// Note: these types implement the legacy github.com/golang/protobuf
+// proto.Message interface (Reset/String/ProtoMessage), not the
+// protoreflect-based one real protoc-gen-go output would produce - replacing
+// this file with real codegen is required before relying on wire-format
+// compatibility with other protobuf implementations.
+
+package categoriespb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type Category struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Category) Reset()         { *x = Category{} }
+func (x *Category) String() string { return proto.CompactTextString(x) }
+func (*Category) ProtoMessage()    {}
+
+func (x *Category) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type ListCategoriesRequest struct{}
+
+func (x *ListCategoriesRequest) Reset()         { *x = ListCategoriesRequest{} }
+func (x *ListCategoriesRequest) String() string { return proto.CompactTextString(x) }
+func (*ListCategoriesRequest) ProtoMessage()    {}
+
+type ListCategoriesResponse struct {
+	Categories []*Category `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+}
+
+func (x *ListCategoriesResponse) Reset()         { *x = ListCategoriesResponse{} }
+func (x *ListCategoriesResponse) String() string { return proto.CompactTextString(x) }
+func (*ListCategoriesResponse) ProtoMessage()    {}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type CreateCategoryRequest struct {
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateCategoryRequest) Reset()         { *x = CreateCategoryRequest{} }
+func (x *CreateCategoryRequest) String() string { return proto.CompactTextString(x) }
+func (*CreateCategoryRequest) ProtoMessage()    {}
+
+func (x *CreateCategoryRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateCategoryResponse struct {
+	Category *Category `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+}
+
+func (x *CreateCategoryResponse) Reset()         { *x = CreateCategoryResponse{} }
+func (x *CreateCategoryResponse) String() string { return proto.CompactTextString(x) }
+func (*CreateCategoryResponse) ProtoMessage()    {}
+
+func (x *CreateCategoryResponse) GetCategory() *Category {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Hand-maintained stand-in for protoc-gen-go-grpc output, mirroring
+// api/proto/categories.proto's CategoriesService. Run `make proto` to
+// regenerate this from source once protoc-gen-go-grpc is available; keep it
+// in sync by hand until then.
+
+package categoriespb
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+const (
+	CategoriesService_ListCategories_FullMethodName = "/categories.CategoriesService/ListCategories"
+	CategoriesService_CreateCategory_FullMethodName = "/categories.CategoriesService/CreateCategory"
+)
+
+// CategoriesServiceClient is the client API for CategoriesService.
+type CategoriesServiceClient interface {
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+}
+
+type categoriesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoriesServiceClient(cc grpc.ClientConnInterface) CategoriesServiceClient {
+	return &categoriesServiceClient{cc}
+}
+
+func (c *categoriesServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	if err := c.cc.Invoke(ctx, CategoriesService_ListCategories_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoriesServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	out := new(CreateCategoryResponse)
+	if err := c.cc.Invoke(ctx, CategoriesService_CreateCategory_FullMethodName, in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoriesServiceServer is the server API for CategoriesService.
+type CategoriesServiceServer interface {
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	mustEmbedUnimplementedCategoriesServiceServer()
+}
+
+// UnimplementedCategoriesServiceServer must be embedded to have forward
+// compatible implementations.
+type UnimplementedCategoriesServiceServer struct{}
+
+func (UnimplementedCategoriesServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+
+func (UnimplementedCategoriesServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+
+func (UnimplementedCategoriesServiceServer) mustEmbedUnimplementedCategoriesServiceServer() {}
+
+func RegisterCategoriesServiceServer(s grpc.ServiceRegistrar, srv CategoriesServiceServer) {
+	s.RegisterService(&CategoriesService_ServiceDesc, srv)
+}
+
+func _CategoriesService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoriesServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: CategoriesService_ListCategories_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoriesServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoriesService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoriesServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{Server: srv, FullMethod: CategoriesService_CreateCategory_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoriesServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoriesService_ServiceDesc is the grpc.ServiceDesc for CategoriesService.
+var CategoriesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "categories.CategoriesService",
+	HandlerType: (*CategoriesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "ListCategories", Handler: _CategoriesService_ListCategories_Handler},
+		{MethodName: "CreateCategory", Handler: _CategoriesService_CreateCategory_Handler},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/proto/categories.proto",
+}
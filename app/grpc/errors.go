@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mytheresa/go-hiring-challenge/app/cart"
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// statusError maps a repository/service error to the grpc-status code its
+// HTTP counterpart would use (api.WriteRepositoryError, cart.Handler's
+// writeServiceError and app/catalog's writeHandlerError switches),
+// so gRPC callers get codes.NotFound/InvalidArgument instead of an opaque
+// codes.Unknown for the same failures the HTTP handlers turn into 404/400.
+func statusError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, gorm.ErrRecordNotFound),
+		errors.Is(err, models.ErrCartItemNotFound),
+		errors.Is(err, cart.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, cart.ErrInvalidQuantity),
+		errors.Is(err, catalog.ErrInvalidCategory):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
@@ -0,0 +1,24 @@
+package grpc
+
+import (
+	"github.com/mytheresa/go-hiring-challenge/app/cart"
+	"github.com/mytheresa/go-hiring-challenge/app/catalog"
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/cartpb"
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/catalogpb"
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/categoriespb"
+	"github.com/mytheresa/go-hiring-challenge/models"
+	"google.golang.org/grpc"
+)
+
+// NewServer builds the gRPC server that exposes CatalogService,
+// CategoriesService and CartService on top of the same services/repositories
+// the HTTP handlers use.
+func NewServer(catalogSvc *catalog.Service, catRepo models.CategoriesRepositoryInterface, cartService *cart.Service) *grpc.Server {
+	srv := grpc.NewServer()
+
+	catalogpb.RegisterCatalogServiceServer(srv, NewCatalogServer(catalogSvc))
+	categoriespb.RegisterCategoriesServiceServer(srv, NewCategoriesServer(catRepo))
+	cartpb.RegisterCartServiceServer(srv, NewCartServer(cartService))
+
+	return srv
+}
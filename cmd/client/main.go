@@ -0,0 +1,53 @@
+// Command client is a minimal example of talking to the catalog gRPC
+// service, useful for smoke-testing a running server or as a starting point
+// for an internal consumer that wants to skip the HTTP/JSON transport.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/mytheresa/go-hiring-challenge/app/grpc/catalogpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:9090", "catalog gRPC server address")
+	code := flag.String("code", "", "if set, fetch a single product by code instead of listing")
+	category := flag.String("category", "", "category filter for the list request")
+	limit := flag.Int("limit", 10, "limit for the list request")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Fatalf("failed to dial %s: %v", *addr, err)
+	}
+	defer conn.Close()
+
+	client := catalogpb.NewCatalogServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if *code != "" {
+		resp, err := client.GetProduct(ctx, &catalogpb.GetProductRequest{Code: *code})
+		if err != nil {
+			log.Fatalf("GetProduct failed: %v", err)
+		}
+		fmt.Printf("%+v\n", resp)
+		return
+	}
+
+	resp, err := client.ListProducts(ctx, &catalogpb.ListProductsRequest{
+		Category: *category,
+		Limit:    int32(*limit),
+	})
+	if err != nil {
+		log.Fatalf("ListProducts failed: %v", err)
+	}
+	fmt.Printf("%+v\n", resp)
+}
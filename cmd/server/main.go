@@ -3,23 +3,42 @@ package main
 import (
 	"context"
 	"fmt"
-	"log"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/mytheresa/go-hiring-challenge/app/api"
+	"github.com/mytheresa/go-hiring-challenge/app/applog"
+	"github.com/mytheresa/go-hiring-challenge/app/cart"
 	"github.com/mytheresa/go-hiring-challenge/app/catalog"
 	"github.com/mytheresa/go-hiring-challenge/app/category"
 	"github.com/mytheresa/go-hiring-challenge/app/database"
+	apigrpc "github.com/mytheresa/go-hiring-challenge/app/grpc"
 	"github.com/mytheresa/go-hiring-challenge/models"
 )
 
+// defaultRequestTimeoutEnv names the env var holding the default duration a
+// route may run before the request middleware gives up on it, unless
+// overridden per route below; fallbackRequestTimeout applies when it is
+// unset or not a valid duration.
+const defaultRequestTimeoutEnv = "REQUEST_TIMEOUT"
+const fallbackRequestTimeout = 5 * time.Second
+
+// shutdownTimeout bounds how long, once a shutdown signal arrives, in-flight
+// HTTP and gRPC requests get to finish before the servers are stopped hard.
+const shutdownTimeout = 10 * time.Second
+
 func main() {
+	logger := applog.NewFromEnv()
+
 	// Load environment variables from .env file
 	if err := godotenv.Load(".env"); err != nil {
-		log.Fatalf("Error loading .env file: %s", err)
+		logger.Error("failed to load .env file", "error", err)
+		os.Exit(1)
 	}
 
 	// signal handling for graceful shutdown
@@ -36,44 +55,124 @@ func main() {
 
 	defer func() {
 		if err := closeDB(); err != nil {
-			log.Printf("Error closing database connection: %s", err)
+			logger.Error("failed to close database connection", "error", err)
 		}
 	}()
 
 	// Initialize handlers
 	prodRepo := models.NewProductsRepository(db)
+	prodRepo.Use(
+		models.AuditLogHook(logger),
+	)
 	catRepo := models.NewCategoriesRepository(db)
 
-	catalogHandler := catalog.NewCatalogHandler(prodRepo)
+	cartRepo := models.NewCartRepository(db)
+	cartService := cart.NewService(cartRepo, prodRepo)
+
+	catalogSvc := catalog.NewService(prodRepo, catRepo)
+	catalogHandler := catalog.NewCatalogHandler(catalogSvc)
 	categoryHandler := categories.NewCategoriesHandler(catRepo)
+	cartHandler := cart.NewHandler(cartService)
+
+	// Per-route request timeouts: the catalog listing joins against
+	// categories and can page through more rows, so it gets more headroom
+	// than the by-code lookup.
+	defaultTimeout := fallbackRequestTimeout
+	if v := os.Getenv(defaultRequestTimeoutEnv); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			defaultTimeout = d
+		}
+	}
+	routeTimeouts := map[string]time.Duration{
+		"GET /catalog": 10 * time.Second,
+	}
+	withTimeout := func(route string, h http.Handler) http.Handler {
+		d, ok := routeTimeouts[route]
+		if !ok {
+			d = defaultTimeout
+		}
+		return api.Timeout(h, d)
+	}
 
 	// Set up routing
 	mux := http.NewServeMux()
-	mux.HandleFunc("GET /catalog", catalogHandler.HandleGet)
-	mux.HandleFunc("GET /catalog/{code}", catalogHandler.HandleGetByCode)
+	mux.Handle("GET /catalog", withTimeout("GET /catalog", catalog.StdHandler("GET /catalog", catalogHandler.HandleGet)))
+	mux.Handle("GET /catalog/{code}", withTimeout("GET /catalog/{code}", catalog.StdHandler("GET /catalog/{code}", catalogHandler.HandleGetByCode)))
 
 	// Categories routes
-	mux.HandleFunc("GET /categories", categoryHandler.HandleGetAll)
-	mux.HandleFunc("POST /categories", categoryHandler.HandleCreate)
+	mux.Handle("GET /categories", withTimeout("GET /categories", http.HandlerFunc(categoryHandler.HandleGetAll)))
+	mux.Handle("POST /categories", withTimeout("POST /categories", http.HandlerFunc(categoryHandler.HandleCreate)))
+
+	// Cart routes, addressed by an explicit cart ID in the path
+	mux.Handle("POST /carts/{id}/items", withTimeout("POST /carts/{id}/items", http.HandlerFunc(cartHandler.HandleAddOrUpdateItem)))
+	mux.Handle("DELETE /carts/{id}/items/{code}", withTimeout("DELETE /carts/{id}/items/{code}", http.HandlerFunc(cartHandler.HandleRemoveItem)))
+	mux.Handle("GET /carts/{id}", withTimeout("GET /carts/{id}", http.HandlerFunc(cartHandler.HandleGetCart)))
+
+	// Cart routes, addressed by the caller's X-Cart-Session-Id header rather
+	// than an ID in the path
+	mux.Handle("POST /cart/items", withTimeout("POST /cart/items", http.HandlerFunc(cartHandler.HandleAddOrUpdateSessionItem)))
+	mux.Handle("DELETE /cart/items/{code}", withTimeout("DELETE /cart/items/{code}", http.HandlerFunc(cartHandler.HandleRemoveSessionItem)))
+	mux.Handle("GET /cart", withTimeout("GET /cart", http.HandlerFunc(cartHandler.HandleGetSessionCart)))
 
 	// Set up the HTTP server
 	srv := &http.Server{
 		Addr:    fmt.Sprintf("localhost:%s", os.Getenv("HTTP_PORT")),
-		Handler: mux,
+		Handler: api.RequestLogging(logger)(mux),
+	}
+
+	// Set up the gRPC server, mirroring the HTTP catalog/categories handlers
+	grpcSrv := apigrpc.NewServer(catalogSvc, catRepo, cartService)
+	grpcLis, err := net.Listen("tcp", fmt.Sprintf("localhost:%s", os.Getenv("GRPC_PORT")))
+	if err != nil {
+		logger.Error("gRPC listener failed", "error", err)
+		os.Exit(1)
 	}
 
-	// Start the server
+	// Start the servers
 	go func() {
-		log.Printf("Starting server on http://%s", srv.Addr)
+		logger.Info("starting HTTP server", "addr", srv.Addr)
 		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Server failed: %s", err)
+			logger.Error("HTTP server failed", "error", err)
+			os.Exit(1)
 		}
 
-		log.Println("Server stopped gracefully")
+		logger.Info("HTTP server stopped gracefully")
+	}()
+
+	go func() {
+		logger.Info("starting gRPC server", "addr", grpcLis.Addr().String())
+		if err := grpcSrv.Serve(grpcLis); err != nil {
+			logger.Error("gRPC server failed", "error", err)
+			os.Exit(1)
+		}
 	}()
 
 	<-ctx.Done()
-	log.Println("Shutting down server...")
-	srv.Shutdown(ctx)
+	logger.Info("shutting down server")
+
+	// ctx is already done here (that's what just woke us up), so draining
+	// in-flight requests needs a fresh context rather than the spent signal
+	// one - otherwise Shutdown treats it as "stop waiting now" and returns
+	// immediately.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("HTTP server shutdown error", "error", err)
+	}
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		grpcSrv.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	select {
+	case <-grpcStopped:
+	case <-shutdownCtx.Done():
+		logger.Error("gRPC server did not stop gracefully in time, forcing shutdown")
+		grpcSrv.Stop()
+	}
+
 	stop()
 }
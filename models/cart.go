@@ -0,0 +1,32 @@
+package models
+
+import (
+	"github.com/shopspring/decimal"
+)
+
+// Cart is a named collection of CartItems. Carts are created implicitly the
+// first time a given ID is read or has an item added under it.
+type Cart struct {
+	ID    uint       `gorm:"primaryKey"`
+	Items []CartItem `gorm:"foreignKey:CartID"`
+}
+
+func (c *Cart) TableName() string {
+	return "carts"
+}
+
+// CartItem snapshots the product price at the time it was added to the
+// cart, so later catalog price changes don't silently change what a
+// customer already has in their cart.
+type CartItem struct {
+	ID        uint            `gorm:"primaryKey"`
+	CartID    uint            `gorm:"column:cart_id;uniqueIndex:idx_cart_items_cart_product;not null"`
+	ProductID uint            `gorm:"column:product_id;uniqueIndex:idx_cart_items_cart_product;not null"`
+	Product   Product         `gorm:"foreignKey:ProductID"`
+	Quantity  int             `gorm:"not null"`
+	Price     decimal.Decimal `gorm:"type:decimal(10,2);not null"`
+}
+
+func (ci *CartItem) TableName() string {
+	return "cart_items"
+}
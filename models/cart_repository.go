@@ -0,0 +1,88 @@
+package models
+
+import (
+	"context"
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ErrCartItemNotFound is returned by RemoveItem when the cart has no line
+// for the given product.
+var ErrCartItemNotFound = errors.New("cart item not found")
+
+// CartRepositoryInterface defines the contract for cart data access.
+type CartRepositoryInterface interface {
+	GetCart(ctx context.Context, cartID uint) (*Cart, error)
+	AddOrUpdateItem(ctx context.Context, cartID uint, productID uint, price decimal.Decimal, quantity int) error
+	RemoveItem(ctx context.Context, cartID uint, productID uint) error
+}
+
+type CartRepository struct {
+	db *gorm.DB
+}
+
+// Ensure CartRepository implements the interface
+var _ CartRepositoryInterface = (*CartRepository)(nil)
+
+func NewCartRepository(db *gorm.DB) *CartRepository {
+	return &CartRepository{db: db}
+}
+
+func (r *CartRepository) GetCart(ctx context.Context, cartID uint) (*Cart, error) {
+	cart := Cart{ID: cartID}
+	err := r.db.WithContext(ctx).
+		Preload("Items.Product").
+		FirstOrCreate(&cart, Cart{ID: cartID}).
+		Error
+	if err != nil {
+		return nil, err
+	}
+	return &cart, nil
+}
+
+// AddOrUpdateItem sets the quantity for productID on cartID to quantity,
+// creating the cart and the line if they don't exist yet. The insert is an
+// upsert keyed on idx_cart_items_cart_product (the unique index on
+// cart_id+product_id), so two concurrent first-adds of the same product
+// resolve at the database level instead of racing a SELECT against a
+// Create: whichever insert loses the race updates the row the other one
+// just created rather than failing the unique constraint.
+//
+// This package has no test double for *gorm.DB against a real database
+// (CartRepository is only exercised through migrations/integration setup
+// outside this repo), so the race this closes isn't covered by a Go test
+// here; app/cart's MockCartRepository serializes calls behind a mutex and
+// can't reproduce it either. ON CONFLICT is what actually has to hold.
+func (r *CartRepository) AddOrUpdateItem(ctx context.Context, cartID uint, productID uint, price decimal.Decimal, quantity int) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.FirstOrCreate(&Cart{ID: cartID}, Cart{ID: cartID}).Error; err != nil {
+			return err
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "cart_id"}, {Name: "product_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"price", "quantity"}),
+		}).Create(&CartItem{
+			CartID:    cartID,
+			ProductID: productID,
+			Price:     price,
+			Quantity:  quantity,
+		}).Error
+	})
+}
+
+func (r *CartRepository) RemoveItem(ctx context.Context, cartID uint, productID uint) error {
+	result := r.db.WithContext(ctx).
+		Where("cart_id = ? AND product_id = ?", cartID, productID).
+		Delete(&CartItem{})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrCartItemNotFound
+	}
+	return nil
+}
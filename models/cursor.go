@@ -0,0 +1,71 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+)
+
+// CursorDirNext and CursorDirPrev select which way GetProducts walks from a
+// ProductCursor: forward (id, code) > cursor, or backward (id, code) < cursor
+// with the resulting page reversed back into ascending order.
+const (
+	CursorDirNext = "next"
+	CursorDirPrev = "prev"
+)
+
+// ProductCursor is the decoded form of the opaque, base64-encoded pagination
+// cursor GetProducts accepts in ProductQueryParameters.Cursor: the last (id,
+// code) tuple seen, the direction to continue in, and a fingerprint of the
+// filters that produced it. The fingerprint lets GetProducts refuse a cursor
+// that's being replayed against a different category or price_less_than
+// filter than the one it was minted under.
+type ProductCursor struct {
+	ID          uint   `json:"id"`
+	Code        string `json:"code"`
+	Dir         string `json:"dir"`
+	Fingerprint string `json:"fp"`
+}
+
+// ProductFilterFingerprint hashes the filters in opts so a cursor can be
+// tied to them; it ignores Offset/Limit/Cursor/Sort, which don't affect
+// which rows are eligible - only which order they come back in, and cursor
+// mode rejects a Sort outright rather than needing to fingerprint it.
+func ProductFilterFingerprint(opts ProductQueryParameters) string {
+	priceLessThan := ""
+	if opts.PriceLessThan != nil {
+		priceLessThan = opts.PriceLessThan.String()
+	}
+
+	h := sha256.New()
+	fmt.Fprintf(h, "category=%s;price_less_than=%s;search=%s", opts.Category, priceLessThan, opts.Search)
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// EncodeProductCursor opaquely encodes a cursor to hand back to the client.
+func EncodeProductCursor(id uint, code, dir, fingerprint string) string {
+	b, _ := json.Marshal(ProductCursor{ID: id, Code: code, Dir: dir, Fingerprint: fingerprint})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeProductCursor reverses EncodeProductCursor, rejecting anything that
+// isn't validly-formed base64/JSON or that carries an unknown direction.
+func DecodeProductCursor(s string) (ProductCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return ProductCursor{}, fmt.Errorf("invalid cursor encoding")
+	}
+
+	var c ProductCursor
+	if err := json.Unmarshal(b, &c); err != nil {
+		return ProductCursor{}, fmt.Errorf("invalid cursor payload")
+	}
+
+	if c.Dir != CursorDirNext && c.Dir != CursorDirPrev {
+		return ProductCursor{}, fmt.Errorf("invalid cursor direction")
+	}
+
+	return c, nil
+}
@@ -0,0 +1,55 @@
+package models
+
+import (
+	"context"
+	"log/slog"
+)
+
+// PreFindHook runs before GetProducts queries the database. It may mutate
+// opts (e.g. to enforce a filtering policy) and/or return an error, which
+// aborts the query and is returned to the caller without ever touching the
+// DB.
+type PreFindHook func(ctx context.Context, opts *ProductQueryParameters) error
+
+// PostFindHook runs after GetProducts queries the database (or after a
+// PreFindHook aborted it). It can observe or replace the result set and the
+// error before GetProducts returns them.
+type PostFindHook func(ctx context.Context, opts *ProductQueryParameters, products *[]Product, total *int64, err *error)
+
+// SoftDeleteFilterHook returns a PreFindHook enforcing that GetProducts
+// never returns soft-deleted products, regardless of what the caller asked
+// for.
+//
+// Not wired in cmd/server/main.go: Product has no deleted_at column (and
+// this repo has no migration mechanism to add one), so queryProducts'
+// "products.deleted_at IS NULL" filter would fail every query if this ran
+// against the real database. Wire it once Product gains a real DeletedAt
+// column.
+func SoftDeleteFilterHook() PreFindHook {
+	return func(ctx context.Context, opts *ProductQueryParameters) error {
+		opts.excludeDeleted = true
+		return nil
+	}
+}
+
+// AuditLogHook returns a PostFindHook that records every GetProducts call -
+// its filters, how many rows it returned, and whether it errored - to
+// logger.
+func AuditLogHook(logger *slog.Logger) PostFindHook {
+	return func(ctx context.Context, opts *ProductQueryParameters, products *[]Product, total *int64, err *error) {
+		attrs := []any{
+			"category", opts.Category,
+			"search", opts.Search,
+			"limit", opts.Limit,
+			"returned", len(*products),
+			"total", *total,
+		}
+
+		if *err != nil {
+			logger.Error("products query", append(attrs, "error", *err)...)
+			return
+		}
+
+		logger.Info("products query", attrs...)
+	}
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProducts_PreHookError(t *testing.T) {
+	repo := &ProductsRepository{}
+	wantErr := errors.New("pre hook rejected this query")
+	repo.Use(PreFindHook(func(ctx context.Context, opts *ProductQueryParameters) error {
+		return wantErr
+	}))
+
+	products, total, err := repo.GetProducts(context.Background(), ProductQueryParameters{Limit: 10})
+
+	assert.Same(t, wantErr, err)
+	assert.Nil(t, products)
+	assert.Zero(t, total)
+}
+
+func TestGetProducts_PostHookSeesError(t *testing.T) {
+	repo := &ProductsRepository{}
+	wantErr := errors.New("pre hook rejected this query")
+	var sawErr error
+
+	repo.Use(
+		PreFindHook(func(ctx context.Context, opts *ProductQueryParameters) error {
+			return wantErr
+		}),
+		PostFindHook(func(ctx context.Context, opts *ProductQueryParameters, products *[]Product, total *int64, err *error) {
+			sawErr = *err
+		}),
+	)
+
+	_, _, err := repo.GetProducts(context.Background(), ProductQueryParameters{Limit: 10})
+
+	require.Error(t, err)
+	assert.Same(t, wantErr, sawErr)
+}
+
+func TestSoftDeleteFilterHook(t *testing.T) {
+	opts := ProductQueryParameters{}
+	require.NoError(t, SoftDeleteFilterHook()(context.Background(), &opts))
+	assert.True(t, opts.excludeDeleted)
+}
+
+func TestAuditLogHook(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	hook := AuditLogHook(logger)
+
+	products := []Product{{Code: "SKU-1"}}
+	total := int64(1)
+	var err error
+	opts := ProductQueryParameters{Category: "SHOES"}
+
+	hook(context.Background(), &opts, &products, &total, &err)
+	assert.Contains(t, buf.String(), `"returned":1`)
+
+	buf.Reset()
+	queryErr := errors.New("database exploded")
+	hook(context.Background(), &opts, &products, &total, &queryErr)
+	assert.Contains(t, buf.String(), "database exploded")
+}
@@ -9,17 +9,44 @@ type ProductQueryParameters struct {
 	PaginationQueryParameters
 	Category      string
 	PriceLessThan *decimal.Decimal
+
+	// Cursor, when set, switches GetProducts from the deprecated Offset/Limit
+	// pagination to cursor-based pagination: an opaque token produced by
+	// EncodeProductCursor, naming the last (id, code) row seen.
+	Cursor string
+
+	// Sort orders results by one or more whitelisted fields; GetProducts
+	// always appends an id tiebreaker so ordering stays deterministic even
+	// when every requested field ties.
+	Sort []SortField
+
+	// Search matches against code/name/description via a parameterized
+	// ILIKE; callers are expected to cap its length before it reaches here.
+	Search string
+
+	// excludeDeleted is set by SoftDeleteFilterHook, not by API callers; it
+	// isn't exported so nothing outside this package can opt out of it.
+	excludeDeleted bool
+}
+
+// SortField is one comma-separated entry of the catalog's "sort" query
+// parameter, e.g. "price" or "-code".
+type SortField struct {
+	Field string
+	Desc  bool
 }
 
 // Product represents a product in the catalog.
 // It includes a unique code and a price.
 type Product struct {
-	ID         uint            `gorm:"primaryKey"`
-	Code       string          `gorm:"uniqueIndex;not null"`
-	Price      decimal.Decimal `gorm:"type:decimal(10,2);not null"`
-	CategoryID *uint           `gorm:"column:category_id"`
-	Category   *Category       `gorm:"foreignKey:CategoryID"`
-	Variants   []Variant       `gorm:"foreignKey:ProductID"`
+	ID          uint            `gorm:"primaryKey"`
+	Code        string          `gorm:"uniqueIndex;not null"`
+	Name        string          `gorm:"column:name"`
+	Description string          `gorm:"column:description"`
+	Price       decimal.Decimal `gorm:"type:decimal(10,2);not null"`
+	CategoryID  *uint           `gorm:"column:category_id"`
+	Category    *Category       `gorm:"foreignKey:CategoryID"`
+	Variants    []Variant       `gorm:"foreignKey:ProductID"`
 }
 
 func (p *Product) TableName() string {
@@ -2,10 +2,21 @@ package models
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"gorm.io/gorm"
 )
 
+// productSortColumns whitelists the fields GetProducts will sort by,
+// mapping the logical name a client supplies in "sort" to the actual
+// column. Building ORDER BY from this map instead of the raw query value
+// keeps sort immune to SQL injection.
+var productSortColumns = map[string]string{
+	"price": "products.price",
+	"code":  "products.code",
+}
+
 // ProductsRepositoryInterface defines the contract for product data access
 type ProductsRepositoryInterface interface {
 	GetAllProducts(ctx context.Context) ([]Product, error)
@@ -15,6 +26,9 @@ type ProductsRepositoryInterface interface {
 
 type ProductsRepository struct {
 	db *gorm.DB
+
+	preHooks  []PreFindHook
+	postHooks []PostFindHook
 }
 
 // Ensure ProductsRepository implements the interface
@@ -34,9 +48,49 @@ func (r *ProductsRepository) GetAllProducts(ctx context.Context) ([]Product, err
 	return products, nil
 }
 
+// Use registers one or more PreFindHook/PostFindHook values to run around
+// every GetProducts call, in registration order. Unrecognized hook types are
+// ignored.
+func (r *ProductsRepository) Use(hooks ...interface{}) {
+	for _, h := range hooks {
+		switch hook := h.(type) {
+		case PreFindHook:
+			r.preHooks = append(r.preHooks, hook)
+		case PostFindHook:
+			r.postHooks = append(r.postHooks, hook)
+		}
+	}
+}
+
+// GetProducts runs the registered PreFindHooks, which can mutate opts or
+// short-circuit the query by returning an error; queries the database only
+// if none did; then runs the registered PostFindHooks, which can observe or
+// replace the result set and the error before it's returned.
 func (r *ProductsRepository) GetProducts(ctx context.Context, opts ProductQueryParameters) ([]Product, int64, error) {
 	var products []Product
 	var total int64
+	var err error
+
+	for _, hook := range r.preHooks {
+		if err = hook(ctx, &opts); err != nil {
+			break
+		}
+	}
+
+	if err == nil {
+		products, total, err = r.queryProducts(ctx, opts)
+	}
+
+	for _, hook := range r.postHooks {
+		hook(ctx, &opts, &products, &total, &err)
+	}
+
+	return products, total, err
+}
+
+func (r *ProductsRepository) queryProducts(ctx context.Context, opts ProductQueryParameters) ([]Product, int64, error) {
+	var products []Product
+	var total int64
 
 	query := r.db.WithContext(ctx).Model(&Product{}).Preload("Category").Preload("Variants")
 
@@ -49,17 +103,92 @@ func (r *ProductsRepository) GetProducts(ctx context.Context, opts ProductQueryP
 		query = query.Where("products.price < ?", opts.PriceLessThan)
 	}
 
+	if opts.Search != "" {
+		pattern := "%" + opts.Search + "%"
+		query = query.Where("products.code ILIKE ? OR products.name ILIKE ? OR products.description ILIKE ?", pattern, pattern, pattern)
+	}
+
+	if opts.excludeDeleted {
+		query = query.Where("products.deleted_at IS NULL")
+	}
+
 	if err := query.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	if err := query.Offset(opts.Offset).Limit(opts.Limit).Find(&products).Error; err != nil {
+	if opts.Cursor != "" {
+		products, err := r.getProductsByCursor(query, opts)
+		return products, total, err
+	}
+
+	// Deprecated: offset/limit pagination is O(N) on deep pages and unstable
+	// when rows are inserted mid-listing. Prefer Cursor.
+	if err := query.Order(productSortOrder(opts.Sort)).Offset(opts.Offset).Limit(opts.Limit).Find(&products).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return products, total, nil
 }
 
+// productSortOrder translates whitelisted SortField descriptors into an
+// ORDER BY clause, always appending an id tiebreaker so two rows that tie
+// on every requested field still sort deterministically.
+func productSortOrder(sort []SortField) string {
+	clauses := make([]string, 0, len(sort)+1)
+
+	for _, s := range sort {
+		column, ok := productSortColumns[s.Field]
+		if !ok {
+			continue
+		}
+		if s.Desc {
+			column += " DESC"
+		}
+		clauses = append(clauses, column)
+	}
+
+	clauses = append(clauses, "products.id")
+
+	return strings.Join(clauses, ", ")
+}
+
+// getProductsByCursor resolves opts.Cursor against query and walks forward
+// or backward from the (id, code) tuple it names, always returning rows in
+// ascending (id, code) order regardless of direction.
+func (r *ProductsRepository) getProductsByCursor(query *gorm.DB, opts ProductQueryParameters) ([]Product, error) {
+	var products []Product
+
+	cursor, err := DecodeProductCursor(opts.Cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor: %w", err)
+	}
+	if cursor.Fingerprint != ProductFilterFingerprint(opts) {
+		return nil, fmt.Errorf("cursor does not match the current filters")
+	}
+
+	if cursor.Dir == CursorDirPrev {
+		if err := query.Where("(products.id, products.code) < (?, ?)", cursor.ID, cursor.Code).
+			Order("products.id DESC, products.code DESC").
+			Limit(opts.Limit).
+			Find(&products).Error; err != nil {
+			return nil, err
+		}
+		for i, j := 0, len(products)-1; i < j; i, j = i+1, j-1 {
+			products[i], products[j] = products[j], products[i]
+		}
+		return products, nil
+	}
+
+	if err := query.Where("(products.id, products.code) > (?, ?)", cursor.ID, cursor.Code).
+		Order("products.id, products.code").
+		Limit(opts.Limit).
+		Find(&products).Error; err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
 func (r *ProductsRepository) GetProductByCode(ctx context.Context, code string) (*Product, error) {
 	var product Product
 	err := r.db.WithContext(ctx).
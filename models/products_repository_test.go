@@ -0,0 +1,23 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductSortOrder(t *testing.T) {
+	t.Run("translates whitelisted fields to columns and appends an id tiebreaker", func(t *testing.T) {
+		order := productSortOrder([]SortField{{Field: "price"}, {Field: "code", Desc: true}})
+		assert.Equal(t, "products.price, products.code DESC, products.id", order)
+	})
+
+	t.Run("defaults to the id tiebreaker alone when no sort is given", func(t *testing.T) {
+		assert.Equal(t, "products.id", productSortOrder(nil))
+	})
+
+	t.Run("ignores fields outside the whitelist instead of interpolating them", func(t *testing.T) {
+		order := productSortOrder([]SortField{{Field: "price; DROP TABLE products;--"}})
+		assert.Equal(t, "products.id", order)
+	})
+}